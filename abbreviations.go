@@ -1,48 +1,56 @@
 package scs
 
-// The abbreviations contains a list of words that has
-// a specific format in Camel and Pascal Case styles.
-var abbreviations = map[string]string{
-	// Official: https://github.com/golang/lint/blob/master/lint.go#L770
-	// "acl":   "ACL",
-	// "api":   "API",
-	// "ascii": "ASCII",
-	// "cpu":   "CPU",
-	// "css":   "CSS",
-	// "dns":   "DNS",
-	// "eof":   "EOF",
-	// "guid":  "GUID",
-	// "html":  "HTML",
-	// "http":  "HTTP",
-	// "https": "HTTPS",
-	// "id":    "ID",
-	// "ip":    "IP",
-	// "json":  "JSON",
-	// "lhs":   "LHS",
-	// "qps":   "QPS",
-	// "ram":   "RAM",
-	// "rhs":   "RHS",
-	// "rpc":   "RPC",
-	// "sla":   "SLA",
-	// "smtp":  "SMTP",
-	// "sql":   "SQL",
-	// "ssh":   "SSH",
-	// "tcp":   "TCP",
-	// "tls":   "TLS",
-	// "ttl":   "TTL",
-	// "udp":   "UDP",
-	// "ui":    "UI",
-	// "uid":   "UID",
-	// "uuid":  "UUID",
-	// "uri":   "URI",
-	// "url":   "URL",
-	// "utf8":  "UTF8",
-	// "vm":    "VM",
-	// "xml":   "XML",
-	// "xmpp":  "XMPP",
-	// "xsrf":  "XSRF",
-	// "xss":   "XSS",
+// The goLintAbbreviations contains the list of common initialisms of
+// golint, which is also the base of the ST1003 check of staticcheck.
+//
+// Official: https://github.com/golang/lint/blob/master/lint.go#L770
+var goLintAbbreviations = map[string]string{
+	"acl":   "ACL",
+	"api":   "API",
+	"ascii": "ASCII",
+	"cpu":   "CPU",
+	"css":   "CSS",
+	"dns":   "DNS",
+	"eof":   "EOF",
+	"guid":  "GUID",
+	"html":  "HTML",
+	"http":  "HTTP",
+	"https": "HTTPS",
+	"id":    "ID",
+	"ip":    "IP",
+	"json":  "JSON",
+	"lhs":   "LHS",
+	"qps":   "QPS",
+	"ram":   "RAM",
+	"rhs":   "RHS",
+	"rpc":   "RPC",
+	"sla":   "SLA",
+	"smtp":  "SMTP",
+	"sql":   "SQL",
+	"ssh":   "SSH",
+	"tcp":   "TCP",
+	"tls":   "TLS",
+	"ttl":   "TTL",
+	"udp":   "UDP",
+	"ui":    "UI",
+	"uid":   "UID",
+	"uuid":  "UUID",
+	"uri":   "URI",
+	"url":   "URL",
+	"utf8":  "UTF8",
+	"vm":    "VM",
+	"xml":   "XML",
+	"xmpp":  "XMPP",
+	"xsrf":  "XSRF",
+	"xss":   "XSS",
+}
 
+// The defaultAbbreviations contains a list of words that has
+// a specific format in Camel and Pascal Case styles.
+//
+// The "ids" and "ips" (Intrusion Detection/Prevention System) aren't
+// included, because they are the plural forms of "id" and "ip".
+var defaultAbbreviations = map[string]string{
 	"5g":      "5G",      // 5th generation
 	"ack":     "ACK",     // Acknowledgement
 	"acl":     "ACL",     // Access Control List
@@ -163,7 +171,6 @@ var abbreviations = map[string]string{
 	"ide":     "IDE",     // Integrated Development Environment
 	"idf":     "IDF",     // Intermediate distribution frame
 	"idk":     "IDK",     // I don’t know
-	"ids":     "IDS",     // Intrusion Detection System
 	"ie":      "IE",      // Internet Explorer
 	"ieee":    "IEEE",    // Institute for Electrical and Electronic Engineers
 	"ietf":    "IETF",    // Internet Engineering Task Force
@@ -174,7 +181,6 @@ var abbreviations = map[string]string{
 	"imo":     "IMO",     // In my opinion
 	"iops":    "IOPS",    // Input/output Operations Per Second
 	"ip":      "IP",      // Internet Protocol
-	"ips":     "IPS",     // Intrusion prevention system
 	"ipsec":   "IPSec",   // Internet Protocol Security
 	"isis":    "ISIS",    // Intermediate System to Intermediate System
 	"isdn":    "ISDN",    // Integrated Services Digital Network
@@ -0,0 +1,119 @@
+package scs
+
+import "regexp"
+
+var isBackslashCase = regexp.MustCompile(
+	`^` + titledWord + `(\\` + titledWord + `)*$`,
+)
+
+// StrIsBackslash returns true if the string is in Backslash\Case.
+//
+// Backslash case represents capitalized words separated by backslashes,
+// in the same way as namespaces are written in PHP. Abbreviations may be
+// written in upper case, but a segment can't contain other capital letters.
+//
+// Example usage:
+//
+//	scs.StrIsBackslash(`App\Http\Client`) // returns true
+//	scs.StrIsBackslash(`App\HTTP\Client`) // returns true
+//	scs.StrIsBackslash(`app\http\client`) // returns false
+//	scs.StrIsBackslash(`App\HttpClient`)  // returns false
+//	scs.StrIsBackslash(`\App\Http`)       // returns false
+func StrIsBackslash(s string) bool {
+	return isBackslashCase.Match([]byte(s))
+}
+
+// StrToBackslash converts a string to Backslash\Case.
+//
+// This function splits the input string into words, capitalizes each
+// word and joins them with backslashes. Abbreviations are capitalized
+// as regular words, as namespaces are usually written in PHP.
+//
+// Example usage:
+//
+//	scs.StrToBackslash("app http client") // returns `App\Http\Client`
+//	scs.StrToBackslash("app_http_client") // returns `App\Http\Client`
+//	scs.StrToBackslash("Hello World")     // returns `Hello\World`
+func StrToBackslash(s string) string {
+	return defaultConverter.StrToBackslash(s)
+}
+
+// ToBackslash converts a string to Backslash\Case.
+//
+// Unlike the StrToBackslash function, if the source string already has
+// a certain format such as camelCase, kebab-case, PascalCase or snake_case,
+// it will be correctly converted to Backslash\Case.
+//
+// Example usage:
+//
+//	scs.ToBackslash("httpClient")  // returns `Http\Client`
+//	scs.ToBackslash("http-client") // returns `Http\Client`
+//	scs.ToBackslash("HttpClient")  // returns `Http\Client`
+//	scs.ToBackslash(`Http\Client`) // returns `Http\Client`
+func ToBackslash(s string) string {
+	return defaultConverter.ToBackslash(s)
+}
+
+// BackslashToCamel converts a Backslash\Case-style string to camelCase.
+//
+// This function checks if the input string is in Backslash\Case.
+// If it's not, it returns an error.
+//
+// Example usage:
+//
+//	result, err := BackslashToCamel(`App\Http`)
+//	// result: "appHTTP", err: nil
+//
+//	result, err := BackslashToCamel("app.http")
+//	// result: "", err: error (not Backslash\Case)
+func BackslashToCamel(backslash string) (string, error) {
+	return defaultConverter.convert(backslash, Backslash, Camel)
+}
+
+// BackslashToKebab converts a Backslash\Case-style string to kebab-case.
+//
+// This function checks if the input string is in Backslash\Case.
+// If it's not, it returns an error.
+//
+// Example usage:
+//
+//	result, err := BackslashToKebab(`App\Http`)
+//	// result: "app-http", err: nil
+//
+//	result, err := BackslashToKebab("app.http")
+//	// result: "", err: error (not Backslash\Case)
+func BackslashToKebab(backslash string) (string, error) {
+	return defaultConverter.convert(backslash, Backslash, Kebab)
+}
+
+// BackslashToPascal converts a Backslash\Case-style string to PascalCase.
+//
+// This function checks if the input string is in Backslash\Case.
+// If it's not, it returns an error.
+//
+// Example usage:
+//
+//	result, err := BackslashToPascal(`App\Http`)
+//	// result: "AppHTTP", err: nil
+//
+//	result, err := BackslashToPascal("app.http")
+//	// result: "", err: error (not Backslash\Case)
+func BackslashToPascal(backslash string) (string, error) {
+	return defaultConverter.convert(backslash, Backslash, Pascal)
+}
+
+// BackslashToSnake converts a Backslash\Case-style string to snake_case.
+//
+// This function checks if the input string is in Backslash\Case.
+// If it's not, it returns an error.
+//
+// Example usage:
+//
+//	result, err := BackslashToSnake(`App\Http`)
+//	// result: "app_http", err: nil
+//
+//	result, err := BackslashToSnake("app.http")
+//	// result: "", err: error (not Backslash\Case)
+func BackslashToSnake(backslash string) (string, error) {
+	return defaultConverter.convert(backslash, Backslash, Snake)
+}
@@ -0,0 +1,115 @@
+package scs
+
+import "testing"
+
+// TestStrIsBackslash tests StrIsBackslash function.
+func TestStrIsBackslash(t *testing.T) {
+	tests := []struct {
+		value  string
+		result bool
+	}{
+		{"One", true},
+		{"one", false},
+		{`App\Http\Client`, true},
+		{`App\HTTP\Client`, true},
+		{`app\http\client`, false},
+		{`\App\Http`, false},
+		{`App\\Http`, false},
+		{`Ice\9`, true},
+	}
+
+	for _, s := range tests {
+		if r := StrIsBackslash(s.value); s.result != r {
+			t.Errorf("test for `%s` is failed, "+
+				"expected %t but %t", s.value, s.result, r)
+		}
+	}
+}
+
+// TestStrToBackslash tests StrToBackslash function.
+func TestStrToBackslash(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"One", "One"},
+		{" One two Three ", `One\Two\Three`},
+		{"Ice 9", `Ice\9`},
+		{"app http client", `App\Http\Client`},
+		{"app_http-client", `App\Http\Client`},
+	}
+
+	for i, s := range tests {
+		if r := StrToBackslash(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestToBackslash tests ToBackslash function.
+func TestToBackslash(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"httpClient", `Http\Client`},
+		{"http-client", `Http\Client`},
+		{"HttpClient", `Http\Client`},
+		{"http_client", `Http\Client`},
+		{`Http\Client`, `Http\Client`},
+	}
+
+	for i, s := range tests {
+		if r := ToBackslash(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestBackslashTo tests BackslashTo* and *ToBackslash functions.
+func TestBackslashTo(t *testing.T) {
+	tests := []struct {
+		fn     func(string) (string, error)
+		value  string
+		result string
+	}{
+		{BackslashToCamel, `Is\HTTP\Or\HTTPS`, "isHTTPOrHTTPS"},
+		{BackslashToKebab, `Is\HTTP\Or\HTTPS`, "is-http-or-https"},
+		{BackslashToPascal, `Is\HTTP\Or\HTTPS`, "IsHTTPOrHTTPS"},
+		{BackslashToSnake, `Is\HTTP\Or\HTTPS`, "is_http_or_https"},
+		{CamelToBackslash, "isHTTPOrHTTPS", `Is\Http\Or\Https`},
+		{KebabToBackslash, "is-http-or-https", `Is\Http\Or\Https`},
+		{PascalToBackslash, "IsHTTPOrHTTPS", `Is\Http\Or\Https`},
+		{SnakeToBackslash, "is_http_or_https", `Is\Http\Or\Https`},
+	}
+
+	for i, s := range tests {
+		r, err := s.fn(s.value)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r != s.result {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestBackslashToError tests BackslashTo* functions with wrong value.
+func TestBackslashToError(t *testing.T) {
+	notBackslash := `app\http`
+
+	for _, fn := range []func(string) (string, error){
+		BackslashToCamel,
+		BackslashToKebab,
+		BackslashToPascal,
+		BackslashToSnake,
+	} {
+		if _, err := fn(notBackslash); err == nil {
+			t.Error("not backslash converted")
+		}
+	}
+}
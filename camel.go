@@ -1,18 +1,8 @@
 package scs
 
-import (
-	"fmt"
-	"regexp"
-	"strings"
-)
+import "regexp"
 
-var (
-	camelPrep    = regexp.MustCompile("([0-9]+)")
-	camelHead    = regexp.MustCompile("(.)([A-Z][a-z]+)")
-	camelBody    = regexp.MustCompile("([a-z0-9])([A-Z])")
-	camelNumbers = regexp.MustCompile("([0-9]+)")
-	isCamelCase  = regexp.MustCompile(`^[a-z]+((\d)|([A-Za-z0-9]+))*([A-Z])?$`)
-)
+var isCamelCase = regexp.MustCompile(`^[a-z]+((\d)|([A-Za-z0-9]+))*([A-Z])?$`)
 
 // StrIsCamel returns true if the string is in camelCase.
 //
@@ -37,16 +27,17 @@ func StrIsCamel(s string) bool {
 // lowercase, and the first letter of each subsequent word is capitalized.
 // There are no spaces or underscores between words.
 //
-// Note that this function will convert all characters to lowercase, except
-// for the first letter of the first word, which will be lowercase.
+// The words of mixed-case identifiers are split at capital letters, and
+// runs of capitals are split at the abbreviations they contain.
 //
 // Example usage:
 //
 //	scs.StrToCamel("hello_world")  // returns "helloWorld"
 //	scs.StrToCamel("hello-world")  // returns "helloWorld"
 //	scs.StrToCamel("HelloWorld")   // returns "helloWorld"
+//	scs.StrToCamel("HTTPServer")   // returns "httpServer"
 func StrToCamel(s string) string {
-	return toUnited(s, true)
+	return defaultConverter.StrToCamel(s)
 }
 
 // ToCamel converts a string to camelCase.
@@ -70,21 +61,7 @@ func StrToCamel(s string) string {
 //	scs.ToCamel("PascalCase")   // returns "pascalCase"
 //	scs.ToCamel("camelCase")    // returns "camelCase"
 func ToCamel(s string) string {
-	switch {
-	case StrIsCamel(s):
-		return s
-	case StrIsKebab(s):
-		r, _ := KebabToCamel(s)
-		return r
-	case StrIsPascal(s):
-		r, _ := PascalToCamel(s)
-		return r
-	case StrIsSnake(s):
-		r, _ := SnakeToCamel(s)
-		return r
-	}
-
-	return StrToCamel(s)
+	return defaultConverter.ToCamel(s)
 }
 
 // CamelToKebab converts a camelCase-style string to kebab-case.
@@ -92,9 +69,10 @@ func ToCamel(s string) string {
 // This function checks if the input string is in camelCase. If it's not,
 // it returns an error.
 //
-// If the input string is in camelCase, it performs the conversion by
-// inserting hyphens before each capital letter (except the first one)
-// and then converts the string to lowercase.
+// If the input string is in camelCase, it is split into words by the
+// tokenizer, which keeps abbreviations and numbers as separate words
+// ("userID" -> "user", "ID"), and the words are joined with hyphens
+// in lower case.
 //
 // Note that this conversion could fail if the input string is not in
 // camelCase style. In that case, an error will be returned along with
@@ -108,14 +86,7 @@ func ToCamel(s string) string {
 //	result, err := CamelToKebab("HelloWorld")
 //	// result: "", err: error (not camelCase)
 func CamelToKebab(camel string) (string, error) {
-	if !StrIsCamel(camel) {
-		return "", fmt.Errorf("value %s isn't camelCase style", camel)
-	}
-
-	kebab := camelPrep.ReplaceAllString(camel, "-${1}-")
-	kebab = camelHead.ReplaceAllString(kebab, "${1}-${2}")
-	kebab = camelBody.ReplaceAllString(kebab, "${1}-${2}")
-	return strings.ToLower(strings.Trim(kebab, "-")), nil
+	return defaultConverter.convert(camel, Camel, Kebab)
 }
 
 // CamelToPascal converts a camelCase-style string to PascalCase.
@@ -123,9 +94,9 @@ func CamelToKebab(camel string) (string, error) {
 // This function checks if the input string is in camelCase. If it's not,
 // it returns an error.
 //
-// If the input string is in camelCase, it performs the conversion by
-// inserting spaces before each capital letter (except the first one)
-// and then converts the string to PascalCase.
+// If the input string is in camelCase, it is split into words by the
+// tokenizer and each word is capitalized, while abbreviations get their
+// spelling from the dictionary ("userID" -> "UserID").
 //
 // Note that this conversion could fail if the input string is not in
 // camelCase style. In that case, an error will be returned along with
@@ -137,16 +108,9 @@ func CamelToKebab(camel string) (string, error) {
 //	// result: "HelloWorld", err: nil
 //
 //	result, err := CamelToPascal("HelloWorld")
-//	// result: "HelloWorld", err: nil
+//	// result: "", err: error (not camelCase)
 func CamelToPascal(camel string) (string, error) {
-	if !StrIsCamel(camel) {
-		return "", fmt.Errorf("value %s isn't camelCase style", camel)
-	}
-
-	pascal := camelHead.ReplaceAllString(camel, "${1} ${2}")
-	pascal = camelBody.ReplaceAllString(pascal, "${1} ${2}")
-	pascal = camelNumbers.ReplaceAllString(pascal, " ${1} ")
-	return StrToPascal(pascal), nil
+	return defaultConverter.convert(camel, Camel, Pascal)
 }
 
 // CamelToSnake converts a camelCase-style string to snake_case.
@@ -154,9 +118,10 @@ func CamelToPascal(camel string) (string, error) {
 // This function checks if the input string is in camelCase. If it's not,
 // it returns an error.
 //
-// If the input string is in camelCase, it performs the conversion by
-// inserting underscores before each capital letter (except the first one)
-// and then converts the string to snake_case.
+// If the input string is in camelCase, it is split into words by the
+// tokenizer, so runs of capitals are split into known abbreviations
+// ("getHTTPSURL" -> "get", "HTTPS", "URL"), and the words are joined
+// with underscores in lower case.
 //
 // Note that this conversion could fail if the input string is not in
 // camelCase style. In that case, an error will be returned along with
@@ -168,14 +133,76 @@ func CamelToPascal(camel string) (string, error) {
 //	// result: "hello_world", err: nil
 //
 //	result, err := CamelToSnake("HelloWorld")
-//	// result: "hello_world", err: nil
+//	// result: "", err: error (not camelCase)
 func CamelToSnake(camel string) (string, error) {
-	if !StrIsCamel(camel) {
-		return "", fmt.Errorf("value %s isn't camelCase style", camel)
-	}
+	return defaultConverter.convert(camel, Camel, Snake)
+}
+
+// CamelToScreamingSnake converts a camelCase-style string
+// to SCREAMING_SNAKE_CASE.
+//
+// This function checks if the input string is in camelCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := CamelToScreamingSnake("helloWorld")
+//	// result: "HELLO_WORLD", err: nil
+//
+//	result, err := CamelToScreamingSnake("hello-world")
+//	// result: "", err: error (not camelCase)
+func CamelToScreamingSnake(camel string) (string, error) {
+	return defaultConverter.convert(camel, Camel, ScreamingSnake)
+}
+
+// CamelToTrain converts a camelCase-style string to Train-Case.
+//
+// This function checks if the input string is in camelCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := CamelToTrain("xRequestID")
+//	// result: "X-Request-ID", err: nil
+func CamelToTrain(camel string) (string, error) {
+	return defaultConverter.convert(camel, Camel, Train)
+}
+
+// CamelToDot converts a camelCase-style string to dot.case.
+//
+// This function checks if the input string is in camelCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := CamelToDot("helloWorld")
+//	// result: "hello.world", err: nil
+func CamelToDot(camel string) (string, error) {
+	return defaultConverter.convert(camel, Camel, Dot)
+}
+
+// CamelToPath converts a camelCase-style string to path/case.
+//
+// This function checks if the input string is in camelCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := CamelToPath("helloWorld")
+//	// result: "hello/world", err: nil
+func CamelToPath(camel string) (string, error) {
+	return defaultConverter.convert(camel, Camel, Path)
+}
 
-	snake := camelPrep.ReplaceAllString(camel, "_${1}_")
-	snake = camelHead.ReplaceAllString(snake, "${1}_${2}")
-	snake = camelBody.ReplaceAllString(snake, "${1}_${2}")
-	return strings.ToLower(strings.Trim(snake, "_")), nil
+// CamelToBackslash converts a camelCase-style string to Backslash\Case.
+//
+// This function checks if the input string is in camelCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := CamelToBackslash("helloWorld")
+//	// result: `Hello\World`, err: nil
+func CamelToBackslash(camel string) (string, error) {
+	return defaultConverter.convert(camel, Camel, Backslash)
 }
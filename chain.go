@@ -0,0 +1,250 @@
+package scs
+
+import "fmt"
+
+// Chain is a fluent builder of names that carries the first error
+// through the chain.
+//
+// Each method returns a new chain, so a chain can be used as a template
+// for several pipelines. After an error all following steps are skipped,
+// and the error is available from the Err method, similar to bufio.Scanner.
+// The String method returns the value of the last successful step.
+//
+// Example usage:
+//
+//	ch := scs.From("user_id").Detect().Pascal().WithPrefix("Get")
+//	if err := ch.Err(); err != nil {
+//		// handle error
+//	}
+//	ch.String() // GetUserID
+type Chain struct {
+	converter *Converter
+	value     string
+	style     CaseStyle // style of the value, zero if unknown
+	err       error
+}
+
+// From starts a chain with the string, using the default converter.
+// The style of the string is unknown until the Detect or As method
+// is called or the string is converted to a style.
+//
+// Example usage:
+//
+//	scs.From("hello world").Kebab().String() // hello-world
+func From(s string) *Chain {
+	return defaultConverter.From(s)
+}
+
+// From starts a chain with the string, using the rules of the converter.
+// See the From function for details.
+func (c *Converter) From(s string) *Chain {
+	return &Chain{converter: c, value: s}
+}
+
+// Chain starts a chain with the value and the style of the object,
+// using the rules of the object.
+func (o *StringCaseStyle) Chain() *Chain {
+	ch := o.rules().From(o.value)
+	if o.isValid {
+		ch.style = o.style
+	}
+
+	return ch
+}
+
+// The step returns a copy of the chain changed by the function.
+// The function isn't called if the chain has an error.
+func (ch *Chain) step(fn func(next *Chain)) *Chain {
+	next := *ch
+	if next.err == nil {
+		fn(&next)
+	}
+
+	return &next
+}
+
+// Detect detects the style of the value. If the value is written in
+// several styles, the style with the highest priority is used, as the
+// To* functions do. The chain fails with an error wrapping ErrUnknownStyle
+// if the value isn't written in any registered style.
+func (ch *Chain) Detect() *Chain {
+	return ch.step(func(next *Chain) {
+		e, ok := detect(next.value)
+		if !ok {
+			next.err = fmt.Errorf("%w: %q", ErrUnknownStyle, next.value)
+			return
+		}
+
+		next.style = e.flag
+	})
+}
+
+// As declares the style of the value. The chain fails with a *StyleError
+// if the value isn't written in the style.
+func (ch *Chain) As(style CaseStyle) *Chain {
+	return ch.step(func(next *Chain) {
+		e, ok := lookup(style)
+		switch {
+		case !ok:
+			next.err = invalidStyleError(next.value, style)
+		case !e.style.Is(next.value):
+			next.err = notInStyleError(next.value, style)
+		default:
+			next.style = style
+		}
+	})
+}
+
+// To converts the value to the style. If the style of the value is known
+// and the value matches it, the value is converted from that style.
+// Otherwise the value is converted as the Convert function does: a value
+// produced by a previous step may not match its style, such as "9Lives"
+// or "ÄpfelBaum", and it is split into words by the tokenizer.
+func (ch *Chain) To(style CaseStyle) *Chain {
+	return ch.step(func(next *Chain) {
+		if e, ok := lookup(next.style); ok && e.style.Is(next.value) {
+			next.value, next.err = next.converter.convert(next.value,
+				next.style, style)
+		} else {
+			next.value, next.err = next.converter.Convert(next.value, style)
+		}
+
+		if next.err != nil {
+			next.value = ch.value
+			return
+		}
+
+		next.style = style
+	})
+}
+
+// Camel converts the value to camelCase.
+func (ch *Chain) Camel() *Chain {
+	return ch.To(Camel)
+}
+
+// Kebab converts the value to kebab-case.
+func (ch *Chain) Kebab() *Chain {
+	return ch.To(Kebab)
+}
+
+// Pascal converts the value to PascalCase.
+func (ch *Chain) Pascal() *Chain {
+	return ch.To(Pascal)
+}
+
+// Snake converts the value to snake_case.
+func (ch *Chain) Snake() *Chain {
+	return ch.To(Snake)
+}
+
+// ScreamingSnake converts the value to SCREAMING_SNAKE_CASE.
+func (ch *Chain) ScreamingSnake() *Chain {
+	return ch.To(ScreamingSnake)
+}
+
+// Train converts the value to Train-Case.
+func (ch *Chain) Train() *Chain {
+	return ch.To(Train)
+}
+
+// Dot converts the value to dot.case.
+func (ch *Chain) Dot() *Chain {
+	return ch.To(Dot)
+}
+
+// Path converts the value to path/case.
+func (ch *Chain) Path() *Chain {
+	return ch.To(Path)
+}
+
+// Backslash converts the value to Backslash\Case.
+func (ch *Chain) Backslash() *Chain {
+	return ch.To(Backslash)
+}
+
+// The affix adds the words of the string before or after the words
+// of the value and renders them in the style of the value. If the style
+// is unknown, the words are separated by a space.
+func (ch *Chain) affix(s string, before bool) *Chain {
+	return ch.step(func(next *Chain) {
+		if next.style == 0 {
+			if before {
+				next.value = s + " " + next.value
+			} else {
+				next.value = next.value + " " + s
+			}
+
+			return
+		}
+
+		name := next.converter.ParseName(next.value)
+		words := next.converter.ParseName(s)
+		if before {
+			name = words.concat(name)
+		} else {
+			name = name.concat(words)
+		}
+
+		next.value, next.err = name.Format(next.style)
+		if next.err != nil {
+			next.value = ch.value
+		}
+	})
+}
+
+// WithPrefix adds the words of the string before the value.
+//
+// Example usage:
+//
+//	scs.From("user_id").Detect().Pascal().WithPrefix("get").String()
+//	// GetUserID
+func (ch *Chain) WithPrefix(s string) *Chain {
+	return ch.affix(s, true)
+}
+
+// WithSuffix adds the words of the string after the value.
+//
+// Example usage:
+//
+//	scs.From("user").Snake().WithSuffix("ID").String() // user_id
+func (ch *Chain) WithSuffix(s string) *Chain {
+	return ch.affix(s, false)
+}
+
+// Style returns the style of the value, zero if it is unknown.
+func (ch *Chain) Style() CaseStyle {
+	return ch.style
+}
+
+// Err returns the first error of the chain.
+func (ch *Chain) Err() error {
+	return ch.err
+}
+
+// String returns the value of the last successful step of the chain.
+func (ch *Chain) String() string {
+	return ch.value
+}
+
+// Result returns the value and the first error of the chain.
+func (ch *Chain) Result() (string, error) {
+	return ch.value, ch.err
+}
+
+// StringCaseStyle returns a new object with the value and the style
+// of the chain. It returns the error of the chain, or an error wrapping
+// ErrInvalidStyle if the style of the value is unknown.
+func (ch *Chain) StringCaseStyle() (*StringCaseStyle, error) {
+	if ch.err != nil {
+		return nil, ch.err
+	}
+
+	obj, err := ch.converter.New(ch.style)
+	if err != nil {
+		return nil, err
+	}
+
+	obj.value = ch.value
+	return obj, nil
+}
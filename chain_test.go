@@ -0,0 +1,111 @@
+package scs
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestChain tests the fluent chain.
+func TestChain(t *testing.T) {
+	tests := []struct {
+		chain  *Chain
+		result string
+		style  CaseStyle
+		err    bool
+	}{
+		{From("user_id").Detect().Pascal().WithPrefix("Get"),
+			"GetUserID", Pascal, false},
+		{From("hello world").Kebab(), "hello-world", Kebab, false},
+		{From("hello world").WithPrefix("say").Camel(), "sayHelloWorld",
+			Camel, false},
+		{From("HTTPServer").As(Pascal).Snake().WithSuffix("URL"),
+			"http_server_url", Snake, false},
+		{From("max-conns").Detect().ScreamingSnake().WithPrefix("db"),
+			"DB_MAX_CONNS", ScreamingSnake, false},
+		{From("user").Train().WithSuffix("agent").Dot().Path(),
+			"user/agent", Path, false},
+		{From("hello world").Detect().Pascal(), "hello world", 0, true},
+		{From("hello_world").As(Camel).Kebab(), "hello_world", 0, true},
+		{From("hello_world").Snake().To(0).Kebab(), "hello_world", Snake,
+			true},
+		{From("hello").As(0), "hello", 0, true},
+		{From("9 lives").Camel().Kebab(), "9-lives", Kebab, false},
+		{From("äpfel baum").Pascal().Snake(), "äpfel_baum", Snake, false},
+		{From("").Pascal().Snake(), "", Snake, false},
+		{From("9 lives").Pascal().WithSuffix("left").Snake(),
+			"9_lives_left", Snake, false},
+	}
+
+	for i, test := range tests {
+		if r := test.chain.String(); r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+
+		if r := test.chain.Style(); r != test.style {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.style, r)
+		}
+
+		if err := test.chain.Err(); (err != nil) != test.err {
+			t.Errorf("test for %d is failed, unexpected error %v", i, err)
+		}
+	}
+}
+
+// TestChainFirstError tests that the chain keeps the first error.
+func TestChainFirstError(t *testing.T) {
+	ch := From("hello_world").As(Camel).To(0).Kebab()
+	if !errors.Is(ch.Err(), ErrNotCamel) {
+		t.Errorf("unexpected error %v", ch.Err())
+	}
+
+	if _, err := ch.Result(); !errors.Is(err, ErrNotCamel) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if _, err := ch.StringCaseStyle(); err == nil {
+		t.Error("there must be an error")
+	}
+}
+
+// TestChainImmutable tests that each step returns a new chain.
+func TestChainImmutable(t *testing.T) {
+	base := From("user id").Snake()
+	get := base.WithPrefix("get")
+	set := base.WithPrefix("set")
+
+	if base.String() != "user_id" || get.String() != "get_user_id" ||
+		set.String() != "set_user_id" {
+		t.Errorf("unexpected values %s, %s, %s", base, get, set)
+	}
+}
+
+// TestChainObject tests the chain of the object and of the converter.
+func TestChainObject(t *testing.T) {
+	obj, _ := New(Snake, "http server")
+	obj.SetAcronymPolicy(TitleOnly)
+
+	r, err := obj.Chain().Pascal().WithSuffix("url").Result()
+	if err != nil || r != "HttpServerUrl" {
+		t.Errorf("expected HttpServerUrl but %s (%v)", r, err)
+	}
+
+	kebab, err := obj.Chain().Kebab().StringCaseStyle()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !kebab.IsKebab() || kebab.Value() != "http-server" {
+		t.Errorf("expected http-server but %s", kebab.Value())
+	}
+
+	c, _ := NewConverter(WithoutDefaultAbbreviations())
+	if r := c.From("http server").Pascal().String(); r != "HttpServer" {
+		t.Errorf("expected HttpServer but %s", r)
+	}
+
+	if _, err := From("hello world").StringCaseStyle(); err == nil {
+		t.Error("there must be an error for unknown style")
+	}
+}
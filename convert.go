@@ -0,0 +1,47 @@
+package scs
+
+// Convert converts a string of any style to the given style.
+//
+// The style of the string is detected automatically in the same way as
+// by the To* functions: if the string is already written in one of the
+// registered styles, it is converted from that style, otherwise it is
+// converted as plain text. The target style can be any of the built-in
+// styles or a style added by the Register function, so it can be chosen
+// at runtime, for example, from a configuration file.
+//
+// An error wrapping ErrInvalidStyle is returned if the target style
+// isn't registered.
+//
+// Example usage:
+//
+//	result, err := scs.Convert("helloWorld", scs.Snake)
+//	// result: "hello_world", err: nil
+//
+//	result, err = scs.Convert("Hello World", scs.Kebab)
+//	// result: "hello-world", err: nil
+//
+//	result, err = scs.Convert("hello", 0)
+//	// result: "", err: error (incorrect case style)
+func Convert(s string, to CaseStyle) (string, error) {
+	return defaultConverter.Convert(s, to)
+}
+
+// ConvertStrict converts a string from one style to another.
+//
+// Unlike the Convert function, the style of the string isn't detected:
+// the string must be written in the source style, otherwise a *StyleError
+// wrapping the sentinel error of the source style, such as ErrNotCamel,
+// is returned. It works for every pair of registered styles and is
+// the generic form of the XToY functions, e.g. ConvertStrict(s, Camel,
+// Kebab) is the same as CamelToKebab(s).
+//
+// Example usage:
+//
+//	result, err := scs.ConvertStrict("helloWorld", scs.Camel, scs.Train)
+//	// result: "Hello-World", err: nil
+//
+//	result, err = scs.ConvertStrict("hello_world", scs.Camel, scs.Train)
+//	// result: "", err: error (not camelCase)
+func ConvertStrict(s string, from, to CaseStyle) (string, error) {
+	return defaultConverter.ConvertStrict(s, from, to)
+}
@@ -0,0 +1,87 @@
+package scs
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestConvert tests Convert function.
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		value  string
+		to     CaseStyle
+		result string
+	}{
+		{"helloWorld", Snake, "hello_world"},
+		{"hello_world", Camel, "helloWorld"},
+		{"Hello World", Kebab, "hello-world"},
+		{"HELLO_WORLD", Train, "Hello-World"},
+		{"hello/world", Backslash, `Hello\World`},
+		{"http_server", Pascal, "HTTPServer"},
+		{"max-open-conns", adaCase, "Max_Open_Conns"},
+	}
+
+	for i, test := range tests {
+		r, err := Convert(test.value, test.to)
+		if err != nil {
+			t.Fatalf("test for %d is failed, %v", i, err)
+		}
+
+		if r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+
+	if _, err := Convert("hello", 0); !errors.Is(err, ErrInvalidStyle) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+// TestConvertStrict tests ConvertStrict function.
+func TestConvertStrict(t *testing.T) {
+	values := map[CaseStyle]string{
+		Camel:          "userID",
+		Kebab:          "user-id",
+		Pascal:         "UserID",
+		Snake:          "user_id",
+		ScreamingSnake: "USER_ID",
+		Train:          "User-ID",
+		Dot:            "user.id",
+		Path:           "user/id",
+		Backslash:      `User\Id`,
+	}
+
+	// Every pair of styles is converted.
+	for from, value := range values {
+		for to, expected := range values {
+			r, err := ConvertStrict(value, from, to)
+			if err != nil {
+				t.Fatalf("%s from %d to %d: %v", value, from, to, err)
+			}
+
+			if r != expected {
+				t.Errorf("%s from %d to %d: expected %s but %s",
+					value, from, to, expected, r)
+			}
+		}
+	}
+
+	// The custom style doesn't know abbreviations.
+	if r, _ := ConvertStrict("User_Id", adaCase, Pascal); r != "UserID" {
+		t.Errorf("expected UserID but %s", r)
+	}
+
+	if r, _ := ConvertStrict("userID", Camel, adaCase); r != "User_Id" {
+		t.Errorf("expected User_Id but %s", r)
+	}
+
+	_, err := ConvertStrict("hello_world", Camel, Kebab)
+	if !errors.Is(err, ErrNotCamel) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if _, err := ConvertStrict("hello", Camel, 0); !errors.Is(err, ErrInvalidStyle) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
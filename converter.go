@@ -0,0 +1,382 @@
+package scs
+
+import (
+	"strings"
+)
+
+// Converter converts strings between case styles with its own rules,
+// such as the abbreviations dictionary. It can be created correctly
+// through the NewConverter function only.
+//
+// The functions of the package use the default converter, which is
+// configured with the global abbreviations dictionary. A Converter
+// allows different parts of the program to use different rules
+// at the same time. All methods of the Converter are safe for
+// concurrent use.
+type Converter struct {
+	abbreviations *dictionary   // abbreviations dictionary of the converter
+	acronyms      AcronymPolicy // how abbreviations are written
+	numbers       NumberPolicy  // how numbers are split into words
+}
+
+// Option configures a Converter created by the NewConverter function.
+type Option func(*config)
+
+// The config contains the settings collected from the options
+// of the NewConverter function.
+type config struct {
+	base          map[string]string // initial abbreviations dictionary
+	abbreviations []string          // abbreviations added to the dictionary
+	acronyms      AcronymPolicy     // how abbreviations are written
+	numbers       NumberPolicy      // how numbers are split into words
+}
+
+// WithAbbreviations adds abbreviations to the dictionary of the converter.
+// The abbreviations are given in their natural spelling, such as "GraphQL".
+//
+// Example usage:
+//
+//	c, _ := scs.NewConverter(scs.WithAbbreviations("GraphQL", "gRPC"))
+//	c.ToPascal("graphql_api") // returns "GraphQLAPI"
+func WithAbbreviations(abbrs ...string) Option {
+	return func(cfg *config) {
+		cfg.abbreviations = append(cfg.abbreviations, abbrs...)
+	}
+}
+
+// WithoutDefaultAbbreviations creates the converter with an empty
+// abbreviations dictionary, so only the abbreviations added with
+// the WithAbbreviations option are recognized. It is the same as
+// the WithProfile option with the ProfileNone profile.
+//
+// Example usage:
+//
+//	c, _ := scs.NewConverter(scs.WithoutDefaultAbbreviations())
+//	c.ToPascal("http_server") // returns "HttpServer"
+func WithoutDefaultAbbreviations() Option {
+	return WithProfile(ProfileNone)
+}
+
+// The defaultConverter is the converter used by the functions
+// of the package. It shares the global abbreviations dictionary.
+var defaultConverter = &Converter{abbreviations: abbreviations}
+
+// NewConverter returns a pointer to a new Converter configured
+// with the given options.
+//
+// By default the converter has its own copy of the default abbreviations
+// dictionary, so changes of the global dictionary made by AddAbbreviation
+// and RemoveAbbreviation functions don't affect it. An error is returned
+// if any abbreviation of the WithAbbreviations option is incorrect.
+//
+// Example usage:
+//
+//	c, err := scs.NewConverter(
+//		scs.WithoutDefaultAbbreviations(),
+//		scs.WithAbbreviations("ID", "URL"),
+//	)
+//	c.ToCamel("user_id_url")   // returns "userIDURL"
+//	c.ToCamel("http_response") // returns "httpResponse"
+func NewConverter(opts ...Option) (*Converter, error) {
+	cfg := &config{base: defaultAbbreviations}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	c := &Converter{
+		abbreviations: newDictionary(cfg.base),
+		acronyms:      cfg.acronyms,
+		numbers:       cfg.numbers,
+	}
+	for _, abbr := range cfg.abbreviations {
+		if err := c.abbreviations.add(abbr); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// New returns a pointer to a string case style object that uses the rules
+// of the converter. See the New function for details.
+//
+// Example usage:
+//
+//	c, _ := scs.NewConverter(scs.WithProfile(scs.ProfileGoLint))
+//	style, _ := c.New(scs.Pascal, "cat food")
+//	style.Value() // CatFood
+func (c *Converter) New(
+	style CaseStyle,
+	value ...string,
+) (*StringCaseStyle, error) {
+	e, ok := lookup(style)
+	if !ok {
+		obj := &StringCaseStyle{do: func(s string) string { return s }}
+		obj.converter = c
+		return obj, invalidStyleError(strings.Join(value, " "), style)
+	}
+
+	do := c.formatter(e)
+	return &StringCaseStyle{
+		do:        do,
+		converter: c,
+		style:     style,
+		value:     do(strings.Join(value, " ")),
+		isValid:   true,
+	}, nil
+}
+
+// The clone returns a copy of the converter that shares its dictionary.
+func (c *Converter) clone() *Converter {
+	cp := *c
+	return &cp
+}
+
+// The snapshot returns a copy of the converter with a copy of its
+// dictionary, which isn't affected by later changes of the dictionary.
+func (c *Converter) snapshot() *Converter {
+	cp := *c
+	cp.abbreviations = newDictionary(c.abbreviations.copy())
+	return &cp
+}
+
+// AddAbbreviation adds an abbreviation to the dictionary of the converter
+// or changes the spelling of an existing one. See the AddAbbreviation
+// function for details.
+func (c *Converter) AddAbbreviation(abbr string) error {
+	return c.abbreviations.add(abbr)
+}
+
+// RemoveAbbreviation removes an abbreviation from the dictionary
+// of the converter. See the RemoveAbbreviation function for details.
+func (c *Converter) RemoveAbbreviation(abbr string) {
+	c.abbreviations.remove(abbr)
+}
+
+// Abbreviations returns a copy of the dictionary of the converter.
+func (c *Converter) Abbreviations() map[string]string {
+	return c.abbreviations.copy()
+}
+
+// Tokenize splits the string into tokens using the abbreviations
+// dictionary and the number policy of the converter. See the Tokenize
+// function for details.
+func (c *Converter) Tokenize(s string) []Token {
+	return attachNumbers(s, tokenize(s, c.abbreviations), c.numbers)
+}
+
+// Words returns the words of the string as written in the input using
+// the abbreviations dictionary of the converter. See the Words function
+// for details.
+func (c *Converter) Words(s string) []string {
+	return textOf(c.Tokenize(s))
+}
+
+// StrToCamel converts a string to camelCase.
+// See the StrToCamel function for details.
+func (c *Converter) StrToCamel(s string) string {
+	return c.toUnited(s, true)
+}
+
+// StrToKebab converts a string to kebab-case.
+// See the StrToKebab function for details.
+func (c *Converter) StrToKebab(s string) string {
+	return c.toSeparate(s, "-")
+}
+
+// StrToPascal converts a string to PascalCase.
+// See the StrToPascal function for details.
+func (c *Converter) StrToPascal(s string) string {
+	return c.toUnited(s, false)
+}
+
+// StrToSnake converts a string to snake_case.
+// See the StrToSnake function for details.
+func (c *Converter) StrToSnake(s string) string {
+	return c.toSeparate(s, "_")
+}
+
+// StrToScreamingSnake converts a string to SCREAMING_SNAKE_CASE.
+// See the StrToScreamingSnake function for details.
+func (c *Converter) StrToScreamingSnake(s string) string {
+	return strings.ToUpper(c.toSeparate(s, "_"))
+}
+
+// StrToTrain converts a string to Train-Case.
+// See the StrToTrain function for details.
+func (c *Converter) StrToTrain(s string) string {
+	return c.toTitled(s, "-")
+}
+
+// StrToDot converts a string to dot.case.
+// See the StrToDot function for details.
+func (c *Converter) StrToDot(s string) string {
+	return c.toSeparate(s, ".")
+}
+
+// StrToPath converts a string to path/case.
+// See the StrToPath function for details.
+func (c *Converter) StrToPath(s string) string {
+	if e, _ := lookup(Path); keeps(e, s) {
+		return s
+	}
+
+	return c.toSeparate(s, "/")
+}
+
+// StrToBackslash converts a string to Backslash\Case.
+// See the StrToBackslash function for details.
+func (c *Converter) StrToBackslash(s string) string {
+	return joinTitled(lowerAll(c.Words(s)), `\`)
+}
+
+// ToCamel converts a string of any style to camelCase.
+// See the ToCamel function for details.
+func (c *Converter) ToCamel(s string) string {
+	return c.toStyle(s, Camel)
+}
+
+// ToKebab converts a string of any style to kebab-case.
+// See the ToKebab function for details.
+func (c *Converter) ToKebab(s string) string {
+	return c.toStyle(s, Kebab)
+}
+
+// ToPascal converts a string of any style to PascalCase.
+// See the ToPascal function for details.
+func (c *Converter) ToPascal(s string) string {
+	return c.toStyle(s, Pascal)
+}
+
+// ToSnake converts a string of any style to snake_case.
+// See the ToSnake function for details.
+func (c *Converter) ToSnake(s string) string {
+	return c.toStyle(s, Snake)
+}
+
+// ToScreamingSnake converts a string of any style to SCREAMING_SNAKE_CASE.
+// See the ToScreamingSnake function for details.
+func (c *Converter) ToScreamingSnake(s string) string {
+	return c.toStyle(s, ScreamingSnake)
+}
+
+// ToTrain converts a string of any style to Train-Case.
+// See the ToTrain function for details.
+func (c *Converter) ToTrain(s string) string {
+	return c.toStyle(s, Train)
+}
+
+// ToDot converts a string of any style to dot.case.
+// See the ToDot function for details.
+func (c *Converter) ToDot(s string) string {
+	return c.toStyle(s, Dot)
+}
+
+// ToPath converts a string of any style to path/case.
+// See the ToPath function for details.
+func (c *Converter) ToPath(s string) string {
+	return c.toStyle(s, Path)
+}
+
+// ToBackslash converts a string of any style to Backslash\Case.
+// See the ToBackslash function for details.
+func (c *Converter) ToBackslash(s string) string {
+	return c.toStyle(s, Backslash)
+}
+
+// Convert converts a string of any style to the style.
+// See the Convert function for details.
+func (c *Converter) Convert(s string, to CaseStyle) (string, error) {
+	if _, ok := lookup(to); !ok {
+		return "", invalidStyleError(s, to)
+	}
+
+	return c.toStyle(s, to), nil
+}
+
+// ConvertStrict converts a string from one style to another.
+// See the ConvertStrict function for details.
+func (c *Converter) ConvertStrict(
+	s string,
+	from, to CaseStyle,
+) (string, error) {
+	return c.convert(s, from, to)
+}
+
+// The split splits a value written in the style into words. The built-in
+// styles use the tokenizer of the converter and keep the case of the words.
+func (c *Converter) split(e entry, s string) []string {
+	if cs, ok := e.style.(converterSplitter); ok {
+		return cs.splitWith(s, c)
+	}
+
+	return e.style.Split(s)
+}
+
+// The strTo converts a string of any format to the style.
+func (c *Converter) strTo(s string, e entry) string {
+	if keeps(e, s) {
+		return s
+	}
+
+	return e.style.Join(c.spell(c.Words(s)))
+}
+
+// The keeps returns true if the value is kept as is by the style.
+func keeps(e entry, s string) bool {
+	k, ok := e.style.(segmentKeeper)
+	return ok && k.keeps(s)
+}
+
+// The formatter returns the function that converts a string of any format
+// to the style.
+func (c *Converter) formatter(e entry) func(string) string {
+	return func(s string) string {
+		return c.strTo(s, e)
+	}
+}
+
+// The convert converts a value from one style to another.
+// It returns a *StyleError if a style isn't registered or the value
+// isn't in the source style.
+func (c *Converter) convert(value string, from, to CaseStyle) (string, error) {
+	src, ok := lookup(from)
+	if !ok {
+		return "", invalidStyleError(value, from)
+	}
+
+	dst, ok := lookup(to)
+	if !ok {
+		return "", invalidStyleError(value, to)
+	}
+
+	if !src.style.Is(value) {
+		return "", notInStyleError(value, from)
+	}
+
+	if keeps(dst, value) {
+		return value, nil
+	}
+
+	return dst.style.Join(c.spell(c.split(src, value))), nil
+}
+
+// The toStyle converts a string to the style. If the string is already
+// written in one of the registered styles, it is converted from that
+// style, otherwise it is converted as plain text.
+func (c *Converter) toStyle(s string, style CaseStyle) string {
+	dst, ok := lookup(style)
+	if !ok {
+		return s
+	}
+
+	src, ok := detect(s)
+	switch {
+	case !ok:
+		return c.strTo(s, dst)
+	case src.flag == style:
+		return s
+	}
+
+	return dst.style.Join(c.spell(c.split(src, s)))
+}
@@ -0,0 +1,154 @@
+package scs
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestNewConverter tests NewConverter function.
+func TestNewConverter(t *testing.T) {
+	c, err := NewConverter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r := c.ToPascal("http_server"); r != "HTTPServer" {
+		t.Errorf("expected HTTPServer but %s", r)
+	}
+
+	if _, err := NewConverter(WithAbbreviations("graph-ql")); err == nil {
+		t.Error("there must be an error for incorrect abbreviation")
+	}
+}
+
+// TestConverterOptions tests options of the NewConverter function.
+func TestConverterOptions(t *testing.T) {
+	c, err := NewConverter(
+		WithAbbreviations("ID", "URL"),
+		WithoutDefaultAbbreviations(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"user_id_url", "userIDURL"},
+		{"http_response", "httpResponse"},
+		{"cat-food", "catFood"},
+	}
+
+	for i, test := range tests {
+		if r := c.ToCamel(test.value); r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+
+	if n := len(c.Abbreviations()); n != 2 {
+		t.Errorf("expected 2 abbreviations but %d", n)
+	}
+}
+
+// TestConverterIsolation tests that converters don't share
+// the abbreviations dictionary.
+func TestConverterIsolation(t *testing.T) {
+	defer ResetAbbreviations()
+
+	a, _ := NewConverter()
+	b, _ := NewConverter(WithAbbreviations("GraphQL"))
+
+	RemoveAbbreviation("cat")
+	a.RemoveAbbreviation("api")
+
+	tests := []struct {
+		convert func(string) string
+		result  string
+	}{
+		{a.StrToPascal, "CATFoodApi"},
+		{b.StrToPascal, "CATFoodAPI"},
+		{StrToPascal, "CatFoodAPI"},
+	}
+
+	for i, test := range tests {
+		if r := test.convert("cat food api"); r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+
+	if r := b.ToKebab("GraphQLServer"); r != "graphql-server" {
+		t.Errorf("expected graphql-server but %s", r)
+	}
+
+	if r := ToKebab("GraphQLServer"); r != "graph-ql-server" {
+		t.Errorf("expected graph-ql-server but %s", r)
+	}
+}
+
+// TestConverterMethods tests that the methods of the converter give
+// the same results as the functions of the package.
+func TestConverterMethods(t *testing.T) {
+	c, _ := NewConverter()
+	tests := []struct {
+		method   func(string) string
+		function func(string) string
+	}{
+		{c.StrToCamel, StrToCamel},
+		{c.StrToKebab, StrToKebab},
+		{c.StrToPascal, StrToPascal},
+		{c.StrToSnake, StrToSnake},
+		{c.StrToScreamingSnake, StrToScreamingSnake},
+		{c.StrToTrain, StrToTrain},
+		{c.StrToDot, StrToDot},
+		{c.StrToPath, StrToPath},
+		{c.StrToBackslash, StrToBackslash},
+		{c.ToCamel, ToCamel},
+		{c.ToKebab, ToKebab},
+		{c.ToPascal, ToPascal},
+		{c.ToSnake, ToSnake},
+		{c.ToScreamingSnake, ToScreamingSnake},
+		{c.ToTrain, ToTrain},
+		{c.ToDot, ToDot},
+		{c.ToPath, ToPath},
+		{c.ToBackslash, ToBackslash},
+	}
+
+	values := []string{"XMLHttpRequest", "max_open-conns", "web2print"}
+	for i, test := range tests {
+		for _, value := range values {
+			if m, f := test.method(value), test.function(value); m != f {
+				t.Errorf("test for %d is failed, expected %s but %s",
+					i, f, m)
+			}
+		}
+	}
+}
+
+// TestConverterConcurrency tests concurrent use of the converter.
+func TestConverterConcurrency(t *testing.T) {
+	c, _ := NewConverter()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c.AddAbbreviation("GraphQL")
+				c.RemoveAbbreviation("GraphQL")
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = c.ToSnake("GraphQLServer")
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,99 @@
+package scs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The detect returns the first registered style the string is written in.
+// The styles are checked in the order of registration.
+func detect(s string) (entry, bool) {
+	for _, e := range entries() {
+		if e.style.Is(s) {
+			return e, true
+		}
+	}
+
+	return entry{}, false
+}
+
+// DetectAll returns all registered styles the string is written in,
+// combined into a single CaseStyle bit mask. It returns zero if the
+// string isn't written in any style.
+//
+// A string can be written in several styles at once. For example, a single
+// lowercase word such as "hello" is valid camelCase, kebab-case, snake_case,
+// dot.case and path/case. Use the Has method of the CaseStyle to check
+// the result.
+//
+// Example usage:
+//
+//	styles := scs.DetectAll("hello")
+//	styles.Has(scs.Camel)  // true
+//	styles.Has(scs.Snake)  // true
+//	styles.Has(scs.Pascal) // false
+//
+//	scs.DetectAll("hello_world") == scs.Snake // true, unambiguous
+func DetectAll(s string) CaseStyle {
+	var styles CaseStyle
+	for _, e := range entries() {
+		if e.style.Is(s) {
+			styles |= e.flag
+		}
+	}
+
+	return styles
+}
+
+// Detect returns the case style the string is written in.
+//
+// If the string is written in exactly one style, that style is returned.
+// If it is written in several styles, such as "hello", which is valid
+// camelCase, kebab-case and snake_case, the style with the highest
+// priority is returned along with an error wrapping ErrAmbiguousStyle.
+// The priority is the order of registration, the same order is used by
+// the To* functions. If the string isn't written in any style, zero and
+// an error wrapping ErrUnknownStyle are returned.
+//
+// The words of Train-Case and Backslash\Case can't contain capital letters
+// other than abbreviations, so "HelloWorld" is PascalCase only, while
+// a single word such as "Hello" is valid in all three styles.
+//
+// Example usage:
+//
+//	style, err := scs.Detect("helloWorld")
+//	// style: scs.Camel, err: nil
+//
+//	style, err = scs.Detect("HelloWorld")
+//	// style: scs.Pascal, err: nil
+//
+//	style, err = scs.Detect("hello")
+//	// style: scs.Camel, errors.Is(err, scs.ErrAmbiguousStyle): true
+//
+//	style, err = scs.Detect("hello world")
+//	// style: 0, errors.Is(err, scs.ErrUnknownStyle): true
+func Detect(s string) (CaseStyle, error) {
+	var names []string
+	first, ok := entry{}, false
+	for _, e := range entries() {
+		if !e.style.Is(s) {
+			continue
+		}
+
+		if !ok {
+			first, ok = e, true
+		}
+
+		names = append(names, e.name)
+	}
+
+	switch len(names) {
+	case 0:
+		return 0, fmt.Errorf("%w: %q", ErrUnknownStyle, s)
+	case 1:
+		return first.flag, nil
+	}
+
+	return first.flag, fmt.Errorf("%w: %q can be %s style",
+		ErrAmbiguousStyle, s, strings.Join(names, ", "))
+}
@@ -0,0 +1,88 @@
+package scs
+
+import "testing"
+
+// TestDetect tests Detect function.
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		value  string
+		result CaseStyle
+		err    bool
+	}{
+		{"helloWorld", Camel, false},
+		{"HelloWorld", Pascal, false},
+		{"HelloWorld123", Pascal, false},
+		{"HTTPServer", Pascal, false},
+		{"hello_world", Snake, false},
+		{"hello-world", Kebab, false},
+		{"HELLO_WORLD", ScreamingSnake, false},
+		{"Hello-World", Train, false},
+		{"hello.world", Dot, false},
+		{"hello/world", Path, false},
+		{"users/profile-image", Path, false},
+		{`Hello\World`, Backslash, false},
+		{"hello", Camel, true},
+		{"HELLO", Pascal, true},
+		{"hello world", 0, true},
+		{"", 0, true},
+	}
+
+	for i, test := range tests {
+		r, err := Detect(test.value)
+		if r != test.result {
+			t.Errorf("test for %d is failed, expected %d but %d",
+				i, test.result, r)
+		}
+
+		if (err != nil) != test.err {
+			t.Errorf("test for %d is failed, unexpected error %v", i, err)
+		}
+	}
+}
+
+// TestDetectAll tests DetectAll function.
+func TestDetectAll(t *testing.T) {
+	tests := []struct {
+		value  string
+		result CaseStyle
+	}{
+		{"hello", Camel | Kebab | Snake | Dot | Path},
+		{"HELLO", Pascal | ScreamingSnake | Train | Backslash},
+		{"Hello", Pascal | Train | Backslash},
+		{"helloWorld", Camel},
+		{"hello_world", Snake},
+		{"HELLO_WORLD", ScreamingSnake},
+		{"hello world", 0},
+	}
+
+	for i, test := range tests {
+		// The styles registered by other tests are ignored.
+		if r := DetectAll(test.value) &^ adaCase; r != test.result {
+			t.Errorf("test for %d is failed, expected %d but %d",
+				i, test.result, r)
+		}
+	}
+}
+
+// TestCaseStyleHas tests Has method of the CaseStyle.
+func TestCaseStyleHas(t *testing.T) {
+	styles := DetectAll("hello")
+	tests := []struct {
+		style  CaseStyle
+		result bool
+	}{
+		{Camel, true},
+		{Snake, true},
+		{Snake | Kebab, true},
+		{Snake | Pascal, false},
+		{Pascal, false},
+		{0, false},
+	}
+
+	for i, test := range tests {
+		if r := styles.Has(test.style); r != test.result {
+			t.Errorf("test for %d is failed, expected %t but %t",
+				i, test.result, r)
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package scs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The dictionary is a set of abbreviations that is safe for concurrent
+// use. The keys are abbreviations in lower case and the values are
+// abbreviations in their natural spelling, such as "http": "HTTP".
+type dictionary struct {
+	sync.RWMutex
+	words map[string]string
+
+	// The maxLen is the length of the longest key. It's never
+	// decreased, so it's the upper bound only.
+	maxLen int
+}
+
+// The abbreviations is the global dictionary used by the functions
+// of the package.
+var abbreviations = newDictionary(defaultAbbreviations)
+
+// The newDictionary returns a new dictionary with a copy of the words.
+func newDictionary(words map[string]string) *dictionary {
+	d := &dictionary{}
+	d.reset(words)
+	return d
+}
+
+// The pluralAbbreviations contains the abbreviations whose plural forms
+// are recognized in lower case, such as "ids" -> "IDs". Plural forms of
+// other abbreviations are recognized only if the abbreviation is written
+// in capitals, such as "CATs", so ordinary words like "has", "its" or
+// "cats" aren't mistaken for abbreviations.
+var pluralAbbreviations = map[string]bool{
+	"api":  true,
+	"cpu":  true,
+	"dns":  true,
+	"guid": true,
+	"id":   true,
+	"ip":   true,
+	"sdk":  true,
+	"ssh":  true,
+	"uri":  true,
+	"url":  true,
+	"uuid": true,
+	"vm":   true,
+	"vpn":  true,
+}
+
+// The get returns the natural spelling of the abbreviation.
+// The word is looked up in lower case.
+//
+// The plural forms of abbreviations are recognized as well: the "s"
+// ending for any abbreviation (ids -> IDs, urls -> URLs) and the "es"
+// ending for abbreviations ending in s, x, z, ch or sh (sshes -> SSHes).
+// The plural form is recognized if the abbreviation is one of the
+// pluralAbbreviations or if it's written in capitals with the ending
+// in lower case (CATs -> CATs, but cats -> cats).
+// The exact match takes precedence, so "https" remains "HTTPS".
+func (d *dictionary) get(word string) (string, bool) {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.find(word)
+}
+
+// The find is the get for the caller that holds the read lock.
+// It's called for every candidate word of the tokenizer, so the word
+// is lowered into a buffer on the stack instead of allocating a new
+// string. The keys of the dictionary are ASCII, so the non-ASCII words
+// and the words longer than any abbreviation with the plural ending
+// are rejected without lookup.
+func (d *dictionary) find(word string) (string, bool) {
+	var buf [32]byte
+	if len(word) > d.maxLen+len("es") || len(word) > len(buf) ||
+		!isASCII(word) {
+		return "", false
+	}
+
+	lower := buf[:len(word)]
+	for i := 0; i < len(word); i++ {
+		c := word[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		lower[i] = c
+	}
+
+	if v, ok := d.words[string(lower)]; ok {
+		return v, true
+	}
+
+	for _, ending := range []string{"es", "s"} {
+		n := len(word) - len(ending)
+		if n <= 0 || string(lower[n:]) != ending {
+			continue
+		}
+
+		if ending == "es" && !hasSibilantEnding(lower[:n]) {
+			continue
+		}
+
+		if !isPluralStem(lower[:n], word[:n], word[n:]) {
+			continue
+		}
+
+		if v, ok := d.words[string(lower[:n])]; ok {
+			return v + ending, true
+		}
+	}
+
+	return "", false
+}
+
+// The isASCII returns true if the word consists of ASCII characters only.
+func isASCII(word string) bool {
+	for i := 0; i < len(word); i++ {
+		if word[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+// The isPluralStem returns true if the plural form of the abbreviation
+// can be recognized: the abbreviation is one of the pluralAbbreviations
+// or it's written in capitals followed by the ending in lower case.
+// The lower is the stem in lower case.
+func isPluralStem(lower []byte, stem, ending string) bool {
+	if pluralAbbreviations[string(lower)] {
+		return true
+	}
+
+	return isUpperText(stem) && string(lower) != stem &&
+		strings.IndexFunc(ending, unicode.IsUpper) < 0
+}
+
+// The hasSibilantEnding returns true if the word in lower case ends
+// in s, x, z, ch or sh, so its plural form takes the "es" ending.
+func hasSibilantEnding(word []byte) bool {
+	for _, ending := range []string{"s", "x", "z", "ch", "sh"} {
+		if len(word) >= len(ending) &&
+			string(word[len(word)-len(ending):]) == ending {
+			return true
+		}
+	}
+
+	return false
+}
+
+// The has returns true if the word is an abbreviation.
+func (d *dictionary) has(word string) bool {
+	_, ok := d.get(word)
+	return ok
+}
+
+// The contains is the has for the caller that holds the read lock.
+func (d *dictionary) contains(word string) bool {
+	_, ok := d.find(word)
+	return ok
+}
+
+// The add adds the abbreviation in its natural spelling.
+func (d *dictionary) add(abbr string) error {
+	if !isAbbreviationSpelling(abbr) {
+		return fmt.Errorf("incorrect abbreviation %s", abbr)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	key := strings.ToLower(abbr)
+	d.words[key] = abbr
+	if len(key) > d.maxLen {
+		d.maxLen = len(key)
+	}
+
+	return nil
+}
+
+// The remove removes the abbreviation in any spelling.
+func (d *dictionary) remove(abbr string) {
+	d.Lock()
+	defer d.Unlock()
+
+	delete(d.words, strings.ToLower(abbr))
+}
+
+// The reset replaces all abbreviations with a copy of the words.
+func (d *dictionary) reset(words map[string]string) {
+	d.Lock()
+	defer d.Unlock()
+
+	d.words = make(map[string]string, len(words))
+	d.maxLen = 0
+	for k, v := range words {
+		d.words[k] = v
+		if len(k) > d.maxLen {
+			d.maxLen = len(k)
+		}
+	}
+}
+
+// The copy returns a copy of all abbreviations.
+func (d *dictionary) copy() map[string]string {
+	d.RLock()
+	defer d.RUnlock()
+
+	words := make(map[string]string, len(d.words))
+	for k, v := range d.words {
+		words[k] = v
+	}
+
+	return words
+}
+
+// The isAbbreviationKey matches the abbreviations in lower case: latin
+// letters and digits with at least one letter.
+var isAbbreviationKey = regexp.MustCompile(`^[a-z0-9]*[a-z][a-z0-9]*$`)
+
+// The isAbbreviationSpelling returns true if the abbreviation consists
+// of latin letters and digits only and contains at least one letter.
+// It's the rule for the abbreviations added by the AddAbbreviation
+// function, the WithAbbreviations option and the loaders, so the keys
+// of the dictionary are always ASCII.
+func isAbbreviationSpelling(abbr string) bool {
+	return isASCII(abbr) && isAbbreviationKey.MatchString(strings.ToLower(abbr))
+}
+
+// AddAbbreviation adds an abbreviation to the global dictionary or
+// changes the spelling of an existing one.
+//
+// The abbreviation is given in its natural spelling, which is used
+// in the camelCase, PascalCase and Train-Case styles, and is matched
+// in any case. An error is returned if the abbreviation is empty,
+// contains characters other than latin letters and digits or has
+// no letters.
+//
+// The dictionary is shared by all functions of the package and can be
+// changed concurrently with the conversions.
+//
+// Example usage:
+//
+//	scs.StrToPascal("graphql api") // returns "GraphqlAPI"
+//	scs.AddAbbreviation("GraphQL")
+//	scs.StrToPascal("graphql api") // returns "GraphQLAPI"
+func AddAbbreviation(abbr string) error {
+	return defaultConverter.AddAbbreviation(abbr)
+}
+
+// RemoveAbbreviation removes an abbreviation from the global dictionary,
+// so the word is written as a regular word. The abbreviation is matched
+// in any case. Nothing happens if there is no such abbreviation.
+//
+// Example usage:
+//
+//	scs.StrToPascal("cat food") // returns "CATFood"
+//	scs.RemoveAbbreviation("cat")
+//	scs.StrToPascal("cat food") // returns "CatFood"
+func RemoveAbbreviation(abbr string) {
+	defaultConverter.RemoveAbbreviation(abbr)
+}
+
+// ResetAbbreviations restores the default abbreviations of the global
+// dictionary, discarding all changes made by the AddAbbreviation and
+// RemoveAbbreviation functions.
+//
+// Example usage:
+//
+//	scs.RemoveAbbreviation("cat")
+//	scs.ResetAbbreviations()
+//	scs.StrToPascal("cat food") // returns "CATFood"
+func ResetAbbreviations() {
+	abbreviations.reset(defaultAbbreviations)
+}
+
+// Abbreviations returns a copy of the global dictionary. The keys are
+// abbreviations in lower case and the values are abbreviations in their
+// natural spelling. Changes to the returned map don't affect the
+// dictionary.
+//
+// Example usage:
+//
+//	abbrs := scs.Abbreviations()
+//	abbrs["http"] // "HTTP"
+func Abbreviations() map[string]string {
+	return defaultConverter.Abbreviations()
+}
@@ -0,0 +1,185 @@
+package scs
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestAddAbbreviation tests AddAbbreviation function.
+func TestAddAbbreviation(t *testing.T) {
+	defer ResetAbbreviations()
+
+	if r := StrToPascal("graphql api"); r != "GraphqlAPI" {
+		t.Errorf("expected GraphqlAPI but %s", r)
+	}
+
+	if err := AddAbbreviation("GraphQL"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"graphql api", "GraphQLAPI"},
+		{"GRAPHQL_API", "GraphQLAPI"},
+		{"graphql-api", "GraphQLAPI"},
+	}
+
+	for i, test := range tests {
+		if r := ToPascal(test.value); r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+
+	invalid := []string{"", "graph-ql", "graph ql", "42", "ÄBC", "\u212A"}
+	for i, abbr := range invalid {
+		if err := AddAbbreviation(abbr); err == nil {
+			t.Errorf("test for %d is failed, expected an error", i)
+		}
+	}
+}
+
+// TestRemoveAbbreviation tests RemoveAbbreviation function.
+func TestRemoveAbbreviation(t *testing.T) {
+	defer ResetAbbreviations()
+
+	if r := StrToPascal("cat food"); r != "CATFood" {
+		t.Errorf("expected CATFood but %s", r)
+	}
+
+	RemoveAbbreviation("CAT")
+	if r := StrToPascal("cat food"); r != "CatFood" {
+		t.Errorf("expected CatFood but %s", r)
+	}
+
+	RemoveAbbreviation("unknown")
+}
+
+// TestResetAbbreviations tests ResetAbbreviations function.
+func TestResetAbbreviations(t *testing.T) {
+	RemoveAbbreviation("http")
+	AddAbbreviation("GraphQL")
+	ResetAbbreviations()
+
+	abbrs := Abbreviations()
+	if _, ok := abbrs["graphql"]; ok {
+		t.Error("expected graphql to be removed")
+	}
+
+	if abbrs["http"] != "HTTP" {
+		t.Errorf("expected HTTP but %s", abbrs["http"])
+	}
+}
+
+// TestAbbreviations tests that Abbreviations function returns a copy.
+func TestAbbreviations(t *testing.T) {
+	abbrs := Abbreviations()
+	if len(abbrs) != len(defaultAbbreviations) {
+		t.Errorf("expected %d abbreviations but %d",
+			len(defaultAbbreviations), len(abbrs))
+	}
+
+	delete(abbrs, "http")
+	if r := StrToPascal("http server"); r != "HTTPServer" {
+		t.Errorf("expected HTTPServer but %s", r)
+	}
+}
+
+// TestAbbreviationsConcurrency tests concurrent changes of the dictionary
+// and conversions.
+func TestAbbreviationsConcurrency(t *testing.T) {
+	defer ResetAbbreviations()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				AddAbbreviation("GraphQL")
+				RemoveAbbreviation("GraphQL")
+				_ = Abbreviations()
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = StrToPascal("graphql http server")
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// TestPluralAbbreviations tests plural forms of abbreviations.
+func TestPluralAbbreviations(t *testing.T) {
+	tests := []struct {
+		value  string
+		pascal string
+		snake  string
+	}{
+		{"user ids", "UserIDs", "user_ids"},
+		{"base urls", "BaseURLs", "base_urls"},
+		{"public apis", "PublicAPIs", "public_apis"},
+		{"ssh keys", "SSHKeys", "ssh_keys"},
+		{"known sshes", "KnownSSHes", "known_sshes"},
+		{"https proxy", "HTTPSProxy", "https_proxy"},
+		{"status", "Status", "status"},
+	}
+
+	for i, test := range tests {
+		if r := StrToPascal(test.value); r != test.pascal {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.pascal, r)
+		}
+
+		r, err := PascalToSnake(test.pascal)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r != test.snake {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.snake, r)
+		}
+
+		if r := ToPascal(test.snake); r != test.pascal {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.pascal, r)
+		}
+	}
+
+	if r, _ := CamelToKebab("userIDsByURLs"); r != "user-ids-by-urls" {
+		t.Errorf("expected user-ids-by-urls but %s", r)
+	}
+}
+
+// TestPluralWords tests that ordinary words ending in "s" aren't taken
+// for plural forms of abbreviations.
+func TestPluralWords(t *testing.T) {
+	tests := []struct {
+		convert  func(string) string
+		value    string
+		expected string
+	}{
+		{StrToPascal, "has value", "HasValue"},
+		{StrToTrain, "HasValue", "Has-Value"},
+		{StrToPascal, "its value", "ItsValue"},
+		{StrToSnake, "ItsValue", "its_value"},
+		{StrToPascal, "cats food", "CatsFood"},
+		{StrToCamel, "HasIDs", "hasIDs"},
+		{StrToPascal, "pet CATs", "PetCATs"},
+		{StrToPascal, "USER_IDS", "UserIDs"},
+	}
+
+	for i, test := range tests {
+		if r := test.convert(test.value); r != test.expected {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.expected, r)
+		}
+	}
+}
@@ -1,10 +1,11 @@
 // Package scs (String Case Style) implements methods for converting string
 // cases between different naming conventions: camelCase, kebab-case,
-// PascalCase, and snake_case.
+// PascalCase, snake_case, SCREAMING_SNAKE_CASE, Train-Case, dot.case,
+// path/case and Backslash\Case.
 //
 // # String Case Styles
 //
-// The package supports four main case styles:
+// The package supports the following case styles:
 //
 //   - camelCase: Words are joined together, first word starts with lowercase,
 //     subsequent words start with uppercase (e.g., "helloWorld")
@@ -13,10 +14,20 @@
 //     (e.g., "HelloWorld")
 //   - snake_case: Words are lowercase and separated by underscores
 //     (e.g., "hello_world")
+//   - SCREAMING_SNAKE_CASE: Words are uppercase and separated by underscores
+//     (e.g., "HELLO_WORLD")
+//   - Train-Case: Words are capitalized and separated by hyphens, also known
+//     as HTTP-Header-Case (e.g., "Content-Type", "X-Request-ID")
+//   - dot.case: Words are lowercase and separated by dots (e.g., "db.max.conns")
+//   - path/case: Words are lowercase and separated by slashes, a segment
+//     can be several kebab words (e.g., "users/profile-image")
+//   - Backslash\Case: Words are capitalized and separated by backslashes,
+//     as PHP namespaces; abbreviations are capitalized as regular words
+//     (e.g., "App\Http\Client")
 //
 // # Usage
 //
-// The package provides two main ways to work with string case styles:
+// The package provides three main ways to work with string case styles:
 //
 //  1. Direct conversion functions:
 //     str := scs.StrToCamel("hello-world")    // returns "helloWorld"
@@ -30,23 +41,166 @@
 //     style.ToKebab()  // converts to kebab-case
 //     style.Value()    // returns "hello-world"
 //
+//  3. Fluent chains that carry the first error:
+//     ch := scs.From("user_id").Detect().Pascal().WithPrefix("Get")
+//     ch.String()      // returns "GetUserID"
+//     ch.Err()         // returns nil
+//
+// StringCaseStyle objects implement the JSON and text marshalling
+// interfaces and sql.Scanner, so they can be stored in documents and
+// databases. Decoded values are converted to the style of the object.
+//
+// When the target style is known only at runtime, e.g. from a configuration
+// file, use the Convert and ConvertStrict functions, which accept any pair
+// of registered styles:
+//
+//	scs.Convert("helloWorld", scs.Snake)                 // hello_world, nil
+//	scs.ConvertStrict("hello-world", scs.Kebab, scs.Dot) // hello.world, nil
+//
+// # Style Detection
+//
+// The Detect function returns the style of a string, and the DetectAll
+// function returns all styles the string is written in as a bit mask:
+//
+//	scs.Detect("hello_world")             // Snake, nil
+//	scs.DetectAll("hello").Has(scs.Kebab) // true
+//
+// A CaseStyle prints as its name, and the ParseCaseStyle function returns
+// a style by one of its common spellings. CaseStyle implements the text
+// marshalling interfaces and flag.Value, so it can be used in configs and
+// command-line flags:
+//
+//	scs.ParseCaseStyle("SCREAMING_SNAKE_CASE") // ScreamingSnake, nil
+//	fmt.Println(scs.Camel | scs.Snake)         // camel|snake
+//
+// The Validate function explains why a string isn't written in a style.
+// It returns the violations of the rules of the style with their byte
+// offsets, rule IDs and suggested fixes:
+//
+//	scs.Validate("1stPlace", scs.Camel) // [{0 1 leading-digit ...}]
+//
+// Conversion failures are reported as *StyleError values that wrap
+// sentinel errors, such as ErrInvalidStyle and ErrNotCamel:
+//
+//	_, err := scs.CamelToKebab("hello_world")
+//	errors.Is(err, scs.ErrNotCamel) // true
+//
+// The Key function returns the canonical form of an identifier, which is
+// the same for all its spellings, and the Equal function compares
+// identifiers by their keys:
+//
+//	scs.Key("UserID")              // user_id
+//	scs.Equal("userId", "user-id") // true
+//
+// The generic Map type stores values under identifiers regardless of their
+// style, remembers the original spellings and reports collisions:
+//
+//	var m scs.Map[int]
+//	m.Set("USER_ID", 42)
+//	m.Get("userId") // 42, true
+//
+// # Custom Styles
+//
+// New styles can be added with the Register function. A style is described
+// by the Style interface, which splits a value into words, joins words into
+// a value and validates a value. A registered style can be used with New,
+// CopyTo and To, and can be converted to and from any other style:
+//
+//	ada, _ := scs.Register("Ada_Case", adaStyle{})
+//	style, _ := scs.New(ada, "hello world") // Hello_World
+//	style.To(scs.Kebab)                     // hello-world
+//
+// # Tokenization
+//
+// All conversion functions split strings into words with the same
+// tokenizer, which is also available as the Tokenize and Words functions.
+// A new word starts at any transition between letters, digits and other
+// characters, at a capital letter after a lowercase letter and at the last
+// capital letter of an abbreviation followed by a lowercase letter:
+//
+//	scs.Words("parseHTTP2Request") // [parse HTTP 2 Request]
+//
+// The abbreviations dictionary helps to find the boundaries of acronyms:
+// runs of capitals are split into known abbreviations and plural
+// abbreviations keep their ending, so mixed-case identifiers coming from
+// other languages are re-cased correctly:
+//
+//	scs.StrToSnake("XMLHttpRequest") // xml_http_request
+//	scs.StrToSnake("getHTTPSURL")    // get_https_url
+//	scs.StrToSnake("IDs")            // ids
+//
+// # Names
+//
+// A Name is an identifier parsed into words once. It keeps abbreviations
+// in their natural spelling, so it is rendered into every style without
+// parsing the value again:
+//
+//	name := scs.ParseName("getHTTPSUrl")
+//	name.Snake()  // get_https_url
+//	name.Pascal() // GetHTTPSURL
+//
+// The word operations of a StringCaseStyle object, such as Append,
+// TrimPrefixWord and ReplaceWord, derive names from other names and
+// re-render them in the style of the object:
+//
+//	style, _ := scs.New(scs.Pascal, "User")
+//	style.Append("id").Value() // UserID
+//
+// # Converters
+//
+// The functions of the package use the default converter with the global
+// abbreviations dictionary. A Converter created by the NewConverter
+// function has its own rules, so different parts of a program can convert
+// strings differently at the same time:
+//
+//	c, _ := scs.NewConverter(scs.WithoutDefaultAbbreviations())
+//	c.ToPascal("http_server")   // HttpServer
+//	scs.ToPascal("http_server") // HTTPServer
+//
+// The dictionary of a converter or of a StringCaseStyle object can be
+// chosen from the profiles: ProfileExtended (default), ProfileGoLint
+// (exactly the golint initialisms) and ProfileNone. The acronym policy
+// defines how abbreviations are written: UpperAll (default, HTTPServer),
+// TitleOnly (HttpServer) or Preserve (as written in the input). The number
+// policy defines how digits inside words are split: SplitNumbers (default,
+// utf_8_decoder), AttachToPrevious (utf8_decoder) or AttachToNext.
+//
 // # Special Cases
 //
 // The package handles special cases like abbreviations and numbers:
-//   - Abbreviations are preserved: "HTTP API" -> "HTTPApi" (PascalCase)
+//   - Abbreviations are preserved: "HTTP API" -> "HTTPAPI" (PascalCase)
+//   - Plural abbreviations are recognized: "user ids" -> "UserIDs" (PascalCase)
 //   - Numbers are treated as word boundaries: "web2print" -> "web-2-print" (kebab-case)
 //
+// The abbreviations dictionary can be changed with the AddAbbreviation,
+// RemoveAbbreviation and ResetAbbreviations functions, which are safe
+// for concurrent use:
+//
+//	scs.RemoveAbbreviation("cat")
+//	scs.StrToPascal("cat food") // CatFood
+//
+// Abbreviations can also be loaded from JSON or CSV data, the formats
+// DictionaryJSON and DictionaryCSV, with the LoadAbbreviations and
+// LoadAbbreviationsFS functions.
+//
 // # Thread Safety
 //
 // All functions in this package are thread-safe and can be used concurrently.
-// The StringCaseStyle object methods are not thread-safe and should be
-// protected if used concurrently.
+// The StringCaseStyle object methods are not thread-safe. Objects shared
+// between goroutines should be created by the NewSync function or by the
+// Sync method: the SyncStringCaseStyle object has the same methods, and
+// its conversions are atomic.
 //
 // # Performance
 //
-// The package uses optimized regular expressions and string operations
-// to provide efficient case conversions. Benchmark tests are available
-// in the scs_test.go file.
+// The conversions don't use regular expressions: the string is split
+// into words by the tokenizer in a single pass over its characters,
+// the abbreviations dictionary is locked once per string and the words
+// are looked up without allocating lowercase copies. The cost of the
+// conversion is the tokens of the string and the result itself, so
+// a short string like "Hello World HTTP API" is converted with about
+// ten allocations. Benchmark tests are available in the
+// benchmarks_test.go file and their results in the benchmarks.txt file.
 //
 // # Error Handling
 //
@@ -0,0 +1,113 @@
+package scs
+
+import "regexp"
+
+var isDotCase = regexp.MustCompile(`^[a-z0-9]+(\.[a-z0-9]+)*$`)
+
+// StrIsDot returns true if the string is in dot.case.
+//
+// Dot case represents words separated by dots and does not have any
+// capital letters. It is commonly used for configuration keys.
+//
+// Example usage:
+//
+//	scs.StrIsDot("db.max.open.conns") // returns true
+//	scs.StrIsDot("db.Max.Open")       // returns false
+//	scs.StrIsDot("db..max")           // returns false
+func StrIsDot(s string) bool {
+	return isDotCase.Match([]byte(s))
+}
+
+// StrToDot converts a string to dot.case.
+//
+// This function splits the input string into words, converts each word
+// to lower case and joins them with dots.
+//
+// Example usage:
+//
+//	scs.StrToDot("db max open conns") // returns "db.max.open.conns"
+//	scs.StrToDot("db_max_open_conns") // returns "db.max.open.conns"
+//	scs.StrToDot("Hello World")       // returns "hello.world"
+func StrToDot(s string) string {
+	return defaultConverter.StrToDot(s)
+}
+
+// ToDot converts a string to dot.case.
+//
+// Unlike the StrToDot function, if the source string already has a certain
+// format such as camelCase, kebab-case, PascalCase or snake_case, it will
+// be correctly converted to dot.case.
+//
+// Example usage:
+//
+//	scs.ToDot("maxOpenConns")   // returns "max.open.conns"
+//	scs.ToDot("max-open-conns") // returns "max.open.conns"
+//	scs.ToDot("MaxOpenConns")   // returns "max.open.conns"
+//	scs.ToDot("max.open.conns") // returns "max.open.conns"
+func ToDot(s string) string {
+	return defaultConverter.ToDot(s)
+}
+
+// DotToCamel converts a dot.case-style string to camelCase.
+//
+// This function checks if the input string is in dot.case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := DotToCamel("max.open.conns")
+//	// result: "maxOpenConns", err: nil
+//
+//	result, err := DotToCamel("Max.Open.Conns")
+//	// result: "", err: error (not dot.case)
+func DotToCamel(dot string) (string, error) {
+	return defaultConverter.convert(dot, Dot, Camel)
+}
+
+// DotToKebab converts a dot.case-style string to kebab-case.
+//
+// This function checks if the input string is in dot.case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := DotToKebab("max.open.conns")
+//	// result: "max-open-conns", err: nil
+//
+//	result, err := DotToKebab("Max.Open.Conns")
+//	// result: "", err: error (not dot.case)
+func DotToKebab(dot string) (string, error) {
+	return defaultConverter.convert(dot, Dot, Kebab)
+}
+
+// DotToPascal converts a dot.case-style string to PascalCase.
+//
+// This function checks if the input string is in dot.case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := DotToPascal("max.open.conns")
+//	// result: "MaxOpenConns", err: nil
+//
+//	result, err := DotToPascal("Max.Open.Conns")
+//	// result: "", err: error (not dot.case)
+func DotToPascal(dot string) (string, error) {
+	return defaultConverter.convert(dot, Dot, Pascal)
+}
+
+// DotToSnake converts a dot.case-style string to snake_case.
+//
+// This function checks if the input string is in dot.case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := DotToSnake("max.open.conns")
+//	// result: "max_open_conns", err: nil
+//
+//	result, err := DotToSnake("Max.Open.Conns")
+//	// result: "", err: error (not dot.case)
+func DotToSnake(dot string) (string, error) {
+	return defaultConverter.convert(dot, Dot, Snake)
+}
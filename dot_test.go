@@ -0,0 +1,115 @@
+package scs
+
+import "testing"
+
+// TestStrIsDot tests StrIsDot function.
+func TestStrIsDot(t *testing.T) {
+	tests := []struct {
+		value  string
+		result bool
+	}{
+		{"one", true},
+		{"One", false},
+		{"db.max.open.conns", true},
+		{"db.Max.Open", false},
+		{"db..max", false},
+		{".db.max", false},
+		{"ice.9", true},
+	}
+
+	for _, s := range tests {
+		if r := StrIsDot(s.value); s.result != r {
+			t.Errorf("test for `%s` is failed, "+
+				"expected %t but %t", s.value, s.result, r)
+		}
+	}
+}
+
+// TestStrToDot tests StrToDot function.
+func TestStrToDot(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"One", "one"},
+		{" One two Three ", "one.two.three"},
+		{"Ice 9", "ice.9"},
+		{"db max open conns", "db.max.open.conns"},
+		{"db_max-open conns", "db.max.open.conns"},
+	}
+
+	for i, s := range tests {
+		if r := StrToDot(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestToDot tests ToDot function.
+func TestToDot(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"maxOpenConns", "max.open.conns"},
+		{"max-open-conns", "max.open.conns"},
+		{"MaxOpenConns", "max.open.conns"},
+		{"max_open_conns", "max.open.conns"},
+		{"max.open.conns", "max.open.conns"},
+		{"HTTPToHTTPS", "http.to.https"},
+	}
+
+	for i, s := range tests {
+		if r := ToDot(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestDotTo tests DotTo* and *ToDot functions.
+func TestDotTo(t *testing.T) {
+	tests := []struct {
+		fn     func(string) (string, error)
+		value  string
+		result string
+	}{
+		{DotToCamel, "is.http.or.https", "isHTTPOrHTTPS"},
+		{DotToKebab, "is.http.or.https", "is-http-or-https"},
+		{DotToPascal, "is.http.or.https", "IsHTTPOrHTTPS"},
+		{DotToSnake, "is.http.or.https", "is_http_or_https"},
+		{CamelToDot, "isHTTPOrHTTPS", "is.http.or.https"},
+		{KebabToDot, "is-http-or-https", "is.http.or.https"},
+		{PascalToDot, "IsHTTPOrHTTPS", "is.http.or.https"},
+		{SnakeToDot, "is_http_or_https", "is.http.or.https"},
+	}
+
+	for i, s := range tests {
+		r, err := s.fn(s.value)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r != s.result {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestDotToError tests DotTo* functions with wrong value.
+func TestDotToError(t *testing.T) {
+	notDot := "one_two_three"
+
+	for _, fn := range []func(string) (string, error){
+		DotToCamel,
+		DotToKebab,
+		DotToPascal,
+		DotToSnake,
+	} {
+		if _, err := fn(notDot); err == nil {
+			t.Error("not dot converted")
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package scs
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// The decode sets the value of the object converted to its style.
+// The object that wasn't created by the New function, such as a field
+// of a decoded struct, has no style, so the value is stored as is.
+func (o *StringCaseStyle) decode(s string) error {
+	if o.do == nil {
+		o.value = s
+		return nil
+	}
+
+	o.value = o.do(s)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the value of the object. The method has a value receiver,
+// so the object is encoded both by pointer and by value.
+func (o StringCaseStyle) MarshalText() ([]byte, error) {
+	return []byte(o.value), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+//
+// The text is converted to the style of the object, as the Set method
+// does. If the object wasn't created by the New function, such as
+// a zero value, it has no style and the text is stored as is.
+//
+// Example usage:
+//
+//	style, _ := New(Kebab)
+//	style.UnmarshalText([]byte("Hello World"))
+//	// style.Value(): "hello-world"
+func (o *StringCaseStyle) UnmarshalText(text []byte) error {
+	return o.decode(string(text))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The object is encoded as a JSON string with its value, both by pointer
+// and by value.
+func (o StringCaseStyle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+//
+// The JSON string is converted to the style of the object, as the Set
+// method does, and the JSON null leaves the object unchanged. If the object
+// wasn't created by the New function, such as a zero value or an object
+// allocated by json.Unmarshal, it has no style and the string is stored
+// as is, so encoded objects can be decoded into new structs.
+//
+// Example usage:
+//
+//	var config struct {
+//		Slug *scs.StringCaseStyle `json:"slug"`
+//	}
+//
+//	config.Slug, _ = scs.New(scs.Kebab)
+//	json.Unmarshal([]byte(`{"slug": "Hello World"}`), &config)
+//	// config.Slug.Value(): "hello-world"
+func (o *StringCaseStyle) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == nil {
+		return nil
+	}
+
+	return o.decode(*s)
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// The value from the database is converted to the style of the object,
+// as the Set method does. Strings and byte slices are supported, NULL
+// sets an empty value. If the object wasn't created by the New function,
+// it has no style and the value is stored as is.
+//
+// Example usage:
+//
+//	slug, _ := scs.New(scs.Kebab)
+//	err := db.QueryRow("SELECT title FROM posts").Scan(slug)
+func (o *StringCaseStyle) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		return o.decode("")
+	case string:
+		return o.decode(v)
+	case []byte:
+		return o.decode(string(v))
+	}
+
+	return fmt.Errorf("unsupported type %T of the value", src)
+}
+
+// The valuer is a driver.Valuer of the object.
+type valuer struct {
+	o *StringCaseStyle
+}
+
+// Value implements the driver.Valuer interface.
+func (v valuer) Value() (driver.Value, error) {
+	return v.o.value, nil
+}
+
+// Valuer returns the driver.Valuer of the object, which passes the value
+// of the object to database drivers. The object doesn't implement the
+// driver.Valuer interface itself, because its Value method returns the
+// value as a string, so the object can't be passed to db.Exec directly:
+// pass the result of this method instead.
+//
+// Example usage:
+//
+//	slug, _ := scs.New(scs.Kebab, "Hello World")
+//	db.Exec("INSERT INTO posts (slug) VALUES (?)", slug.Valuer())
+func (o *StringCaseStyle) Valuer() driver.Valuer {
+	return valuer{o}
+}
+
+// String implements the fmt.Stringer interface.
+// It returns the value of the object.
+func (o StringCaseStyle) String() string {
+	return o.value
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %s, %v and %q verbs print the value of the object, and the %+v verb
+// prints the style of the object along with the value.
+//
+// Example usage:
+//
+//	style, _ := New(Snake, "hello world")
+//	fmt.Printf("%s", style)  // hello_world
+//	fmt.Printf("%q", style)  // "hello_world"
+//	fmt.Printf("%+v", style) // {style:snake value:hello_world}
+func (o StringCaseStyle) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "{style:%s value:%s}", o.style, o.value)
+	case verb == 's' || verb == 'v' || verb == 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), o.value)
+	default:
+		fmt.Fprintf(f, "%%!%c(scs.StringCaseStyle=%s)", verb, o.value)
+	}
+}
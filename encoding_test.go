@@ -0,0 +1,215 @@
+package scs
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+var (
+	_ json.Marshaler           = (*StringCaseStyle)(nil)
+	_ json.Unmarshaler         = (*StringCaseStyle)(nil)
+	_ encoding.TextMarshaler   = (*StringCaseStyle)(nil)
+	_ encoding.TextUnmarshaler = (*StringCaseStyle)(nil)
+	_ sql.Scanner              = (*StringCaseStyle)(nil)
+	_ fmt.Stringer             = (*StringCaseStyle)(nil)
+	_ fmt.Formatter            = (*StringCaseStyle)(nil)
+	_ json.Marshaler           = StringCaseStyle{}
+	_ encoding.TextMarshaler   = StringCaseStyle{}
+	_ fmt.Stringer             = StringCaseStyle{}
+	_ fmt.Formatter            = StringCaseStyle{}
+)
+
+// TestObjJSON tests JSON encoding of the object.
+func TestObjJSON(t *testing.T) {
+	var config struct {
+		Slug *StringCaseStyle `json:"slug"`
+		Key  *StringCaseStyle `json:"key"`
+	}
+
+	config.Slug, _ = New(Kebab)
+	config.Key, _ = New(ScreamingSnake, "max conns")
+
+	data := `{"slug": "Hello World"}`
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := config.Slug.Value(); v != "hello-world" {
+		t.Errorf("expected hello-world but %s", v)
+	}
+
+	// The null leaves the object unchanged.
+	if err := config.Key.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := config.Key.Value(); v != "MAX_CONNS" {
+		t.Errorf("expected MAX_CONNS but %s", v)
+	}
+
+	r, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"slug":"hello-world","key":"MAX_CONNS"}`; string(r) != expected {
+		t.Errorf("expected %s but %s", expected, r)
+	}
+
+	if err := json.Unmarshal([]byte(`{"slug": 42}`), &config); err == nil {
+		t.Error("there must be an error for a number")
+	}
+
+	// The object that isn't created by the New function stores
+	// the value as is.
+	var obj StringCaseStyle
+	if err := json.Unmarshal([]byte(`"Hello World"`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := obj.Value(); v != "Hello World" {
+		t.Errorf("expected Hello World but %s", v)
+	}
+}
+
+// TestObjRoundTrip tests decoding of encoded objects into new structs.
+func TestObjRoundTrip(t *testing.T) {
+	type post struct {
+		Slug  StringCaseStyle  `json:"slug"`
+		Title *StringCaseStyle `json:"title"`
+	}
+
+	slug, _ := New(Kebab, "Hello World")
+	title, _ := New(Pascal, "hello world")
+	data, err := json.Marshal(post{*slug, title})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p post
+	if err := json.Unmarshal(data, &p); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := p.Slug.Value(); v != "hello-world" {
+		t.Errorf("expected hello-world but %s", v)
+	}
+
+	if p.Title == nil || p.Title.Value() != "HelloWorld" {
+		t.Errorf("expected HelloWorld but %v", p.Title)
+	}
+
+	var obj StringCaseStyle
+	if err := obj.Scan([]byte("hello_world")); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := obj.Value(); v != "hello_world" {
+		t.Errorf("expected hello_world but %s", v)
+	}
+}
+
+// TestObjText tests text encoding of the object.
+func TestObjText(t *testing.T) {
+	obj, _ := New(Pascal)
+	if err := obj.UnmarshalText([]byte("http server")); err != nil {
+		t.Fatal(err)
+	}
+
+	r, _ := obj.MarshalText()
+	if string(r) != "HTTPServer" {
+		t.Errorf("expected HTTPServer but %s", r)
+	}
+}
+
+// TestObjSQL tests Scan and Valuer methods of the object.
+func TestObjSQL(t *testing.T) {
+	tests := []struct {
+		src    any
+		result string
+		err    bool
+	}{
+		{"Hello World", "hello_world", false},
+		{[]byte("helloWorld"), "hello_world", false},
+		{nil, "", false},
+		{42, "", true},
+	}
+
+	for i, test := range tests {
+		obj, _ := New(Snake)
+		err := obj.Scan(test.src)
+		if (err != nil) != test.err {
+			t.Errorf("test for %d is failed, unexpected error %v", i, err)
+		}
+
+		if v := obj.Value(); v != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, v)
+		}
+	}
+
+	obj, _ := New(Snake, "hello world")
+	var valuer driver.Valuer = obj.Valuer()
+	v, err := valuer.Value()
+	if err != nil || v != "hello_world" {
+		t.Errorf("expected hello_world but %v (%v)", v, err)
+	}
+
+	if !driver.IsValue(v) {
+		t.Errorf("%v isn't a driver value", v)
+	}
+}
+
+// TestObjFormat tests formatting of the object.
+func TestObjFormat(t *testing.T) {
+	obj, _ := New(Snake, "hello world")
+	tests := []struct {
+		format string
+		result string
+	}{
+		{"%s", "hello_world"},
+		{"%v", "hello_world"},
+		{"%q", `"hello_world"`},
+		{"%+v", "{style:snake value:hello_world}"},
+		{"%14s|", "   hello_world|"},
+		{"%d", "%!d(scs.StringCaseStyle=hello_world)"},
+	}
+
+	for i, test := range tests {
+		if r := fmt.Sprintf(test.format, obj); r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+
+	if r := obj.String(); r != "hello_world" {
+		t.Errorf("expected hello_world but %s", r)
+	}
+
+	if r := fmt.Sprintf("%s", *obj); r != "hello_world" {
+		t.Errorf("expected hello_world but %s", r)
+	}
+}
+
+// TestObjMarshalByValue tests encoding of the object stored by value.
+func TestObjMarshalByValue(t *testing.T) {
+	obj, _ := New(Kebab, "hello world")
+
+	r, err := json.Marshal(struct{ S StringCaseStyle }{*obj})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"S":"hello-world"}`; string(r) != expected {
+		t.Errorf("expected %s but %s", expected, r)
+	}
+
+	r, err = (*obj).MarshalText()
+	if err != nil || string(r) != "hello-world" {
+		t.Errorf("expected hello-world but %s", r)
+	}
+}
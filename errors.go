@@ -0,0 +1,153 @@
+package scs
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidStyle is returned when a case style isn't registered.
+	ErrInvalidStyle = errors.New("incorrect case style")
+
+	// ErrUnknownStyle is returned when a value isn't written in any
+	// registered style.
+	ErrUnknownStyle = errors.New("value isn't in any known style")
+
+	// ErrAmbiguousStyle is returned when a value is written in several
+	// registered styles at once.
+	ErrAmbiguousStyle = errors.New("value is ambiguous")
+
+	// ErrNotInStyle matches every error about a value that isn't written
+	// in the expected style, including the styles added by Register.
+	ErrNotInStyle = errors.New("value isn't in the style")
+
+	// ErrNotCamel is returned when a value isn't camelCase.
+	ErrNotCamel = errors.New("value isn't camelCase style")
+
+	// ErrNotKebab is returned when a value isn't kebab-case.
+	ErrNotKebab = errors.New("value isn't kebab-case style")
+
+	// ErrNotPascal is returned when a value isn't PascalCase.
+	ErrNotPascal = errors.New("value isn't PascalCase style")
+
+	// ErrNotSnake is returned when a value isn't snake_case.
+	ErrNotSnake = errors.New("value isn't snake_case style")
+
+	// ErrNotScreamingSnake is returned when a value isn't
+	// SCREAMING_SNAKE_CASE.
+	ErrNotScreamingSnake = errors.New(
+		"value isn't SCREAMING_SNAKE_CASE style")
+
+	// ErrNotTrain is returned when a value isn't Train-Case.
+	ErrNotTrain = errors.New("value isn't Train-Case style")
+
+	// ErrNotDot is returned when a value isn't dot.case.
+	ErrNotDot = errors.New("value isn't dot.case style")
+
+	// ErrNotPath is returned when a value isn't path/case.
+	ErrNotPath = errors.New("value isn't path/case style")
+
+	// ErrNotBackslash is returned when a value isn't Backslash\Case.
+	ErrNotBackslash = errors.New(`value isn't Backslash\Case style`)
+)
+
+// The notInStyle contains the sentinel errors of the built-in styles.
+var notInStyle = map[CaseStyle]error{
+	Camel:          ErrNotCamel,
+	Kebab:          ErrNotKebab,
+	Pascal:         ErrNotPascal,
+	Snake:          ErrNotSnake,
+	ScreamingSnake: ErrNotScreamingSnake,
+	Train:          ErrNotTrain,
+	Dot:            ErrNotDot,
+	Path:           ErrNotPath,
+	Backslash:      ErrNotBackslash,
+}
+
+// StyleError describes a failed conversion of a value.
+//
+// The Err field contains the sentinel error of the failure: ErrInvalidStyle
+// if the expected style isn't registered, or one of the ErrNot* errors if
+// the value isn't written in the expected style. Use errors.Is to check
+// the reason and errors.As to get the details.
+//
+// Example usage:
+//
+//	_, err := scs.CamelToKebab("hello_world")
+//	errors.Is(err, scs.ErrNotCamel)   // true
+//	errors.Is(err, scs.ErrNotInStyle) // true
+//
+//	var styleErr *scs.StyleError
+//	if errors.As(err, &styleErr) {
+//		styleErr.Detected // scs.Snake
+//		styleErr.Position // 5
+//	}
+type StyleError struct {
+	Input    string    // value that failed the conversion
+	Expected CaseStyle // style the value was expected to be in
+	Detected CaseStyle // style the value is written in, zero if unknown
+	Position int       // byte offset of the first violation, -1 if unknown
+	Err      error     // sentinel error of the failure
+}
+
+// Error returns the description of the error.
+func (e *StyleError) Error() string {
+	if errors.Is(e.Err, ErrInvalidStyle) {
+		return ErrInvalidStyle.Error()
+	}
+
+	name := fmt.Sprint(e.Expected)
+	if exp, ok := lookup(e.Expected); ok {
+		name = exp.name
+	}
+
+	return fmt.Sprintf("value %s isn't %s style", e.Input, name)
+}
+
+// Unwrap returns the sentinel error of the failure.
+func (e *StyleError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error matches the target. Every error about
+// a value that isn't in the expected style matches ErrNotInStyle.
+func (e *StyleError) Is(target error) bool {
+	return target == ErrNotInStyle && !errors.Is(e.Err, ErrInvalidStyle)
+}
+
+// The invalidStyleError returns the error for the unregistered style.
+func invalidStyleError(value string, style CaseStyle) error {
+	return &StyleError{
+		Input:    value,
+		Expected: style,
+		Position: -1,
+		Err:      ErrInvalidStyle,
+	}
+}
+
+// The notInStyleError returns the error for the value that isn't written
+// in the style.
+func notInStyleError(value string, style CaseStyle) error {
+	err, ok := notInStyle[style]
+	if !ok {
+		err = ErrNotInStyle
+	}
+
+	position := -1
+	if violations := Validate(value, style); len(violations) != 0 {
+		position = violations[0].Offset
+	}
+
+	var detected CaseStyle
+	if e, ok := detect(value); ok {
+		detected = e.flag
+	}
+
+	return &StyleError{
+		Input:    value,
+		Expected: style,
+		Detected: detected,
+		Position: position,
+		Err:      err,
+	}
+}
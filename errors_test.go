@@ -0,0 +1,130 @@
+package scs
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestStyleError tests errors returned by the conversion functions.
+func TestStyleError(t *testing.T) {
+	tests := []struct {
+		convert  func(string) (string, error)
+		value    string
+		sentinel error
+		expected CaseStyle
+		detected CaseStyle
+		position int
+	}{
+		{CamelToKebab, "hello_world", ErrNotCamel, Camel, Snake, 5},
+		{KebabToCamel, "Hello-World", ErrNotKebab, Kebab, Train, 0},
+		{PascalToSnake, "helloWorld", ErrNotPascal, Pascal, Camel, 0},
+		{SnakeToPascal, "hello world", ErrNotSnake, Snake, 0, 5},
+		{ScreamingSnakeToCamel, "hello_WORLD", ErrNotScreamingSnake,
+			ScreamingSnake, 0, 0},
+		{TrainToSnake, "hello-world", ErrNotTrain, Train, Kebab, 0},
+		{DotToSnake, "hello..world", ErrNotDot, Dot, 0, 5},
+		{PathToSnake, "/hello", ErrNotPath, Path, 0, 0},
+		{BackslashToSnake, `hello\world`, ErrNotBackslash, Backslash, 0, 0},
+	}
+
+	for i, test := range tests {
+		_, err := test.convert(test.value)
+		if !errors.Is(err, test.sentinel) || !errors.Is(err, ErrNotInStyle) {
+			t.Errorf("test for %d is failed, unexpected error %v", i, err)
+		}
+
+		if errors.Is(err, ErrInvalidStyle) {
+			t.Errorf("test for %d is failed, unexpected ErrInvalidStyle", i)
+		}
+
+		var styleErr *StyleError
+		if !errors.As(err, &styleErr) {
+			t.Fatalf("test for %d is failed, expected *StyleError", i)
+		}
+
+		expected := StyleError{test.value, test.expected, test.detected,
+			test.position, test.sentinel}
+		if *styleErr != expected {
+			t.Errorf("test for %d is failed, expected %v but %v",
+				i, expected, *styleErr)
+		}
+	}
+}
+
+// TestStyleErrorInvalidStyle tests errors for the unregistered styles.
+func TestStyleErrorInvalidStyle(t *testing.T) {
+	_, err := New(0, "hello")
+	if !errors.Is(err, ErrInvalidStyle) || errors.Is(err, ErrNotInStyle) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if err.Error() != "incorrect case style" {
+		t.Errorf("unexpected message %s", err)
+	}
+
+	obj, _ := New(Snake, "hello world")
+	err = obj.To(0)
+
+	var styleErr *StyleError
+	if !errors.As(err, &styleErr) || styleErr.Expected != 0 {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if _, err := Register("", adaStyle{}); !errors.Is(err, ErrInvalidStyle) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+// TestDetectErrors tests errors returned by the style detection.
+func TestDetectErrors(t *testing.T) {
+	tests := []struct {
+		err      error
+		sentinel error
+	}{
+		{second(Detect("hello world")), ErrUnknownStyle},
+		{second(Detect("")), ErrUnknownStyle},
+		{second(Detect("hello")), ErrAmbiguousStyle},
+		{From("hello world").Detect().Err(), ErrUnknownStyle},
+		{second(Register("Ada_Case", adaStyle{})), ErrInvalidStyle},
+		{second(Register("Nil_Case", nil)), ErrInvalidStyle},
+	}
+
+	for i, test := range tests {
+		if !errors.Is(test.err, test.sentinel) {
+			t.Errorf("test for %d is failed, expected %v but %v",
+				i, test.sentinel, test.err)
+		}
+	}
+
+	if _, err := Detect("HelloWorld"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+// The second returns the error of the function call.
+func second(_ CaseStyle, err error) error {
+	return err
+}
+
+// TestStyleErrorObject tests errors returned by the methods of the object.
+func TestStyleErrorObject(t *testing.T) {
+	obj, _ := New(Camel, "hello world")
+	obj.value = "hello_world"
+
+	err := obj.ToKebab()
+	if !errors.Is(err, ErrNotCamel) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	var styleErr *StyleError
+	if !errors.As(err, &styleErr) || styleErr.Detected != Snake {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// The custom styles report the generic error.
+	obj, _ = New(adaCase, "hello world")
+	obj.value = "hello_world"
+	if _, err := obj.CopyToSnake(); !errors.Is(err, ErrNotInStyle) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
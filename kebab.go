@@ -1,15 +1,8 @@
 package scs
 
-import (
-	"fmt"
-	"regexp"
-	"strings"
-)
+import "regexp"
 
-var (
-	kebabBody   = regexp.MustCompile("(^[A-Za-z])|-([A-Za-z])")
-	isKebabCase = regexp.MustCompile("(^[a-z0-9]+-[a-z0-9-]+$)|(^[a-z0-9]+$)")
-)
+var isKebabCase = regexp.MustCompile("(^[a-z0-9]+-[a-z0-9-]+$)|(^[a-z0-9]+$)")
 
 // StrIsKebab returns true if the string is in kebab-case.
 //
@@ -38,8 +31,9 @@ func StrIsKebab(s string) bool {
 //	scs.StrToKebab("HelloWorld")   // returns "hello-world"
 //	scs.StrToKebab("hello_world")  // returns "hello-world"
 //	scs.StrToKebab("Hello-World")  // returns "hello-world"
+//	scs.StrToKebab("HTTPServer")   // returns "http-server"
 func StrToKebab(s string) string {
-	return toSeparate(s, "-")
+	return defaultConverter.StrToKebab(s)
 }
 
 // ToKebab converts a string to kebab-case.
@@ -59,21 +53,7 @@ func StrToKebab(s string) string {
 //	scs.ToKebab("hello_world")  // returns "hello-world"
 //	scs.ToKebab("Hello-World")  // returns "hello-world"
 func ToKebab(s string) string {
-	switch {
-	case StrIsCamel(s):
-		r, _ := CamelToKebab(s)
-		return r
-	case StrIsKebab(s):
-		return s
-	case StrIsPascal(s):
-		r, _ := PascalToKebab(s)
-		return r
-	case StrIsSnake(s):
-		r, _ := SnakeToKebab(s)
-		return r
-	}
-
-	return StrToKebab(s)
+	return defaultConverter.ToKebab(s)
 }
 
 // KebabToCamel converts a kebab-case-style string to camelCase.
@@ -81,10 +61,10 @@ func ToKebab(s string) string {
 // This function checks if the input string is in kebab-case. If it's not,
 // it returns an error.
 //
-// If the input string is in kebab-case, it replaces all hyphens with spaces
-// and converts the resulting string to camelCase. CamelCase is a naming
-// convention in which the first letter of each word (including the first
-// word) is capitalized, and there are no spaces or underscores between words.
+// If the input string is in kebab-case, it is split into words at hyphens
+// and numbers, and the words are joined without delimiters: the first
+// word is written in lower case, the others are capitalized, and
+// abbreviations are spelled as in the dictionary ("user-id" -> "userID").
 //
 // Note that this conversion could fail if the input string is not in
 // kebab-case style. In that case, an error will be returned along with
@@ -98,18 +78,7 @@ func ToKebab(s string) string {
 //	result, err := KebabToCamel("Hello-World")
 //	// result: "", err: error (not kebab-case)
 func KebabToCamel(kebab string) (string, error) {
-	if !StrIsKebab(kebab) {
-		return "", fmt.Errorf("value %s isn't kebab-case style", kebab)
-	}
-
-	result := StrToCamel(
-		kebabBody.ReplaceAllStringFunc(
-			kebab,
-			func(s string) string { return strings.Replace(s, "-", " ", -1) },
-		),
-	)
-
-	return result, nil
+	return defaultConverter.convert(kebab, Kebab, Camel)
 }
 
 // KebabToSnake converts a kebab-case-style string to snake_case.
@@ -117,10 +86,9 @@ func KebabToCamel(kebab string) (string, error) {
 // This function checks if the input string is in kebab-case. If it's not,
 // it returns an error.
 //
-// If the input string is in kebab-case, it replaces all hyphens with
-// underscores, effectively converting the string from kebab-case to
-// snake_case. Snake_case is a naming convention in which words are
-// separated by underscores and there are no capital letters.
+// If the input string is in kebab-case, it is split into words by the
+// tokenizer and the words are joined with underscores in lower case.
+// Numbers are separate words, so "web2-print" becomes "web_2_print".
 //
 // Note that this conversion could fail if the input string is not in
 // kebab-case style. In that case, an error will be returned along with
@@ -134,11 +102,7 @@ func KebabToCamel(kebab string) (string, error) {
 //	result, err := KebabToSnake("Hello-World")
 //	// result: "", err: error (not kebab-case)
 func KebabToSnake(kebab string) (string, error) {
-	if !StrIsKebab(kebab) {
-		return "", fmt.Errorf("value %s isn't kebab-case style", kebab)
-	}
-
-	return strings.ReplaceAll(kebab, "-", "_"), nil
+	return defaultConverter.convert(kebab, Kebab, Snake)
 }
 
 // KebabToPascal converts a kebab-case-style string to PascalCase.
@@ -146,11 +110,10 @@ func KebabToSnake(kebab string) (string, error) {
 // This function checks if the input string is in kebab-case. If it's not,
 // it returns an error.
 //
-// If the input string is in kebab-case, it replaces all hyphens with spaces,
-// performs title casing on each word, and joins the words together to form
-// a PascalCase string. PascalCase is a naming convention in which the first
-// letter of each word (including the first word) is capitalized, and there
-// are no underscores or spaces between words.
+// If the input string is in kebab-case, it is split into words by the
+// tokenizer, and the words are capitalized and joined without delimiters.
+// Abbreviations are spelled as in the dictionary ("http-server" ->
+// "HTTPServer").
 //
 // Note that this conversion could fail if the input string is not in
 // kebab-case style. In that case, an error will be returned along with
@@ -164,16 +127,74 @@ func KebabToSnake(kebab string) (string, error) {
 //	result, err := KebabToPascal("Hello-World")
 //	// result: "", err: error (not kebab-case)
 func KebabToPascal(kebab string) (string, error) {
-	if !StrIsKebab(kebab) {
-		return "", fmt.Errorf("value %s isn't kebab-case style", kebab)
-	}
+	return defaultConverter.convert(kebab, Kebab, Pascal)
+}
+
+// KebabToScreamingSnake converts a kebab-case-style string
+// to SCREAMING_SNAKE_CASE.
+//
+// This function checks if the input string is in kebab-case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := KebabToScreamingSnake("hello-world")
+//	// result: "HELLO_WORLD", err: nil
+//
+//	result, err := KebabToScreamingSnake("Hello-World")
+//	// result: "", err: error (not kebab-case)
+func KebabToScreamingSnake(kebab string) (string, error) {
+	return defaultConverter.convert(kebab, Kebab, ScreamingSnake)
+}
+
+// KebabToTrain converts a kebab-case-style string to Train-Case.
+//
+// This function checks if the input string is in kebab-case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := KebabToTrain("x-request-id")
+//	// result: "X-Request-ID", err: nil
+func KebabToTrain(kebab string) (string, error) {
+	return defaultConverter.convert(kebab, Kebab, Train)
+}
 
-	result := StrToPascal(
-		kebabBody.ReplaceAllStringFunc(
-			kebab,
-			func(s string) string { return strings.Replace(s, "-", " ", -1) },
-		),
-	)
+// KebabToDot converts a kebab-case-style string to dot.case.
+//
+// This function checks if the input string is in kebab-case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := KebabToDot("hello-world")
+//	// result: "hello.world", err: nil
+func KebabToDot(kebab string) (string, error) {
+	return defaultConverter.convert(kebab, Kebab, Dot)
+}
+
+// KebabToPath converts a kebab-case-style string to path/case.
+//
+// This function checks if the input string is in kebab-case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := KebabToPath("hello-world")
+//	// result: "hello/world", err: nil
+func KebabToPath(kebab string) (string, error) {
+	return defaultConverter.convert(kebab, Kebab, Path)
+}
 
-	return result, nil
+// KebabToBackslash converts a kebab-case-style string to Backslash\Case.
+//
+// This function checks if the input string is in kebab-case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := KebabToBackslash("hello-world")
+//	// result: `Hello\World`, err: nil
+func KebabToBackslash(kebab string) (string, error) {
+	return defaultConverter.convert(kebab, Kebab, Backslash)
 }
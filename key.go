@@ -0,0 +1,45 @@
+package scs
+
+import "strings"
+
+// Key returns the canonical form of an identifier, which is the same for
+// all spellings of the identifier in any style.
+//
+// The key consists of the words of the string in lower case separated by
+// underscores. The words are found by the shared tokenizer, so boundaries
+// of abbreviations are kept: "UserID", "userId", "user_id" and "user-id"
+// have the same key "user_id", while "userid" is a different identifier.
+// The key can be used as a key of maps and indexes.
+//
+// Example usage:
+//
+//	scs.Key("UserID")       // user_id
+//	scs.Key("HTTPServer")   // http_server
+//	scs.Key("X-Request-ID") // x_request_id
+func Key(s string) string {
+	return defaultConverter.Key(s)
+}
+
+// Equal returns true if two strings are spellings of the same identifier,
+// i.e. they have the same key. See the Key function for details.
+//
+// Example usage:
+//
+//	scs.Equal("userId", "USER_ID") // true
+//	scs.Equal("UserID", "user-id") // true
+//	scs.Equal("UserID", "userid")  // false
+func Equal(a, b string) bool {
+	return defaultConverter.Equal(a, b)
+}
+
+// Key returns the canonical form of an identifier, using the tokenizer
+// of the converter. See the Key function for details.
+func (c *Converter) Key(s string) string {
+	return strings.Join(c.getChunks(s), "_")
+}
+
+// Equal returns true if two strings are spellings of the same identifier,
+// using the tokenizer of the converter. See the Equal function for details.
+func (c *Converter) Equal(a, b string) bool {
+	return c.Key(a) == c.Key(b)
+}
@@ -0,0 +1,61 @@
+package scs
+
+import "testing"
+
+// TestKey tests Key function.
+func TestKey(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"UserID", "user_id"},
+		{"userId", "user_id"},
+		{"user_id", "user_id"},
+		{"user-id", "user_id"},
+		{"USER_ID", "user_id"},
+		{"User-ID", "user_id"},
+		{"user id", "user_id"},
+		{"userid", "userid"},
+		{"HTTPServer", "http_server"},
+		{"UserIDs", "user_ids"},
+		{"X-Request-ID", "x_request_id"},
+		{"", ""},
+	}
+
+	for i, test := range tests {
+		if r := Key(test.value); r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+}
+
+// TestEqual tests Equal function.
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		result bool
+	}{
+		{"userId", "user_id", true},
+		{"UserID", "user-id", true},
+		{"UserID", "USER_ID", true},
+		{"user.id", `User\ID`, true},
+		{"getHTTPSURL", "get_https_url", true},
+		{"user_ids", "UserIDs", true},
+		{"UserID", "userid", false},
+		{"user_id", "user_name", false},
+	}
+
+	for i, test := range tests {
+		if r := Equal(test.a, test.b); r != test.result {
+			t.Errorf("test for %d is failed, expected %t but %t",
+				i, test.result, r)
+		}
+	}
+
+	c, _ := NewConverter(WithNumberPolicy(AttachToPrevious))
+	if !c.Equal("utf8Decoder", "utf8_decoder") ||
+		c.Key("utf8Decoder") != "utf8_decoder" {
+		t.Errorf("expected utf8_decoder but %s", c.Key("utf8Decoder"))
+	}
+}
@@ -0,0 +1,244 @@
+package scs
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// DictionaryFormat is the format of an abbreviations dictionary
+// read by the LoadAbbreviations function.
+type DictionaryFormat uint8
+
+const (
+	// DictionaryJSON is a JSON object in which the keys are abbreviations
+	// in lower case and the values are abbreviations in their natural
+	// spelling, such as {"ios": "iOS", "graphql": "GraphQL"}.
+	DictionaryJSON DictionaryFormat = iota
+
+	// DictionaryCSV is a CSV file with two columns: an abbreviation in lower
+	// case and the abbreviation in its natural spelling, such as
+	// "ios,iOS". Lines starting with # are comments.
+	DictionaryCSV
+)
+
+// Conflict describes an abbreviation of the loaded dictionary that has
+// a different spelling in the dictionary it was merged into.
+type Conflict struct {
+	Key string // abbreviation in lower case
+	Old string // spelling that was in the dictionary
+	New string // spelling that replaced it
+}
+
+// The merge adds the words to the dictionary and returns the list of
+// replaced abbreviations sorted by key.
+func (d *dictionary) merge(words map[string]string) []Conflict {
+	d.Lock()
+	defer d.Unlock()
+
+	var conflicts []Conflict
+	for k, v := range words {
+		if old, ok := d.words[k]; ok && old != v {
+			conflicts = append(conflicts, Conflict{k, old, v})
+		}
+
+		d.words[k] = v
+		if len(k) > d.maxLen {
+			d.maxLen = len(k)
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Key < conflicts[j].Key
+	})
+
+	return conflicts
+}
+
+// The checkAbbreviation returns an error if the key isn't an abbreviation
+// in lower case or if the value isn't a spelling of the key.
+func checkAbbreviation(key, value string) error {
+	if !isAbbreviationKey.MatchString(key) {
+		return fmt.Errorf("key %q isn't latin lowercase alphanumeric", key)
+	}
+
+	if !isAbbreviationSpelling(value) || strings.ToLower(value) != key {
+		return fmt.Errorf("value %s isn't spelling of %s", value, key)
+	}
+
+	return nil
+}
+
+// The readAbbreviations reads and validates the abbreviations.
+func readAbbreviations(
+	r io.Reader,
+	format DictionaryFormat,
+) (map[string]string, error) {
+	words := map[string]string{}
+	switch format {
+	case DictionaryJSON:
+		// The object is read token by token to find duplicate keys,
+		// which are silently overridden by json.Unmarshal.
+		dec := json.NewDecoder(r)
+		if t, err := dec.Token(); err != nil {
+			return nil, err
+		} else if t != json.Delim('{') {
+			return nil, fmt.Errorf("abbreviations must be a JSON object")
+		}
+
+		for dec.More() {
+			t, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			var value string
+			if err := dec.Decode(&value); err != nil {
+				return nil, err
+			}
+
+			key, _ := t.(string)
+			if err := checkAbbreviation(key, value); err != nil {
+				return nil, err
+			}
+
+			if _, ok := words[key]; ok {
+				return nil, fmt.Errorf("duplicate key %s", key)
+			}
+
+			words[key] = value
+		}
+
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+	case DictionaryCSV:
+		reader := csv.NewReader(r)
+		reader.Comment = '#'
+		reader.FieldsPerRecord = 2
+		reader.TrimLeadingSpace = true
+
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+
+			line, _ := reader.FieldPos(0)
+			if err := checkAbbreviation(record[0], record[1]); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+
+			if _, ok := words[record[0]]; ok {
+				return nil, fmt.Errorf("line %d: duplicate key %s",
+					line, record[0])
+			}
+
+			words[record[0]] = record[1]
+		}
+	default:
+		return nil, fmt.Errorf("incorrect format")
+	}
+
+	return words, nil
+}
+
+// The formatOf returns the format of the file by its extension.
+func formatOf(name string) (DictionaryFormat, error) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		return DictionaryJSON, nil
+	case ".csv":
+		return DictionaryCSV, nil
+	}
+
+	return 0, fmt.Errorf("incorrect format of %s", name)
+}
+
+// LoadAbbreviations reads abbreviations from the reader and merges them
+// into the dictionary of the converter. See the LoadAbbreviations
+// function for details.
+func (c *Converter) LoadAbbreviations(
+	r io.Reader,
+	format DictionaryFormat,
+) ([]Conflict, error) {
+	words, err := readAbbreviations(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.abbreviations.merge(words), nil
+}
+
+// LoadAbbreviationsFS reads abbreviations from the file of the file system
+// and merges them into the dictionary of the converter. See the
+// LoadAbbreviationsFS function for details.
+func (c *Converter) LoadAbbreviationsFS(
+	fsys fs.FS,
+	name string,
+) ([]Conflict, error) {
+	format, err := formatOf(name)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts, err := c.LoadAbbreviations(f, format)
+	return conflicts, errors.Join(err, f.Close())
+}
+
+// LoadAbbreviations reads abbreviations from the reader and merges them
+// into the global dictionary.
+//
+// The data can be a JSON object or a two-column CSV, as defined by the
+// format. Every entry is validated: the key must be an abbreviation in
+// latin lowercase letters and digits, the value must be a spelling of
+// the key, i.e. it must lowercase back to the key, such as "ios": "iOS",
+// and every key must occur only once. If any entry is incorrect,
+// an error is returned and the dictionary remains unchanged.
+//
+// The loaded abbreviations replace the existing ones. The abbreviations
+// whose spelling was changed are returned as conflicts.
+//
+// Example usage:
+//
+//	data := `{"ios": "iOS", "graphql": "GraphQL", "oauth": "OAuth"}`
+//	conflicts, err := scs.LoadAbbreviations(
+//		strings.NewReader(data),
+//		scs.DictionaryJSON,
+//	)
+//	scs.ToPascal("oauth_token") // returns "OAuthToken"
+func LoadAbbreviations(
+	r io.Reader,
+	format DictionaryFormat,
+) ([]Conflict, error) {
+	return defaultConverter.LoadAbbreviations(r, format)
+}
+
+// LoadAbbreviationsFS reads abbreviations from the file of the file system,
+// such as embed.FS, and merges them into the global dictionary.
+//
+// The format of the file is defined by its extension: ".json" or ".csv".
+// See the LoadAbbreviations function for details.
+//
+// Example usage:
+//
+//	//go:embed glossary.csv
+//	var glossary embed.FS
+//
+//	conflicts, err := scs.LoadAbbreviationsFS(glossary, "glossary.csv")
+func LoadAbbreviationsFS(fsys fs.FS, name string) ([]Conflict, error) {
+	return defaultConverter.LoadAbbreviationsFS(fsys, name)
+}
@@ -0,0 +1,150 @@
+package scs
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// TestLoadAbbreviations tests LoadAbbreviations function.
+func TestLoadAbbreviations(t *testing.T) {
+	defer ResetAbbreviations()
+
+	tests := []struct {
+		data   string
+		format DictionaryFormat
+	}{
+		{`{"ios": "iOS", "graphql": "GraphQL", "oauth": "OAuth"}`, DictionaryJSON},
+		{"# glossary\nios,iOS\ngraphql, GraphQL\noauth,OAuth\n", DictionaryCSV},
+	}
+
+	for i, test := range tests {
+		ResetAbbreviations()
+		_, err := LoadAbbreviations(strings.NewReader(test.data), test.format)
+		if err != nil {
+			t.Fatalf("test for %d is failed, %v", i, err)
+		}
+
+		if r := ToPascal("oauth_token"); r != "OAuthToken" {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, "OAuthToken", r)
+		}
+
+		if r := ToSnake("GraphQLServer"); r != "graphql_server" {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, "graphql_server", r)
+		}
+
+		if r := ToCamel("ios_app"); r != "iosApp" {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, "iosApp", r)
+		}
+	}
+}
+
+// TestLoadAbbreviationsConflicts tests conflicts reported by
+// LoadAbbreviations function.
+func TestLoadAbbreviationsConflicts(t *testing.T) {
+	c, _ := NewConverter()
+	data := "wifi,WIFI\nhttp,HTTP\ncat,Cat\n"
+	conflicts, err := c.LoadAbbreviations(strings.NewReader(data), DictionaryCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Conflict{
+		{"cat", "CAT", "Cat"},
+		{"wifi", "WiFi", "WIFI"},
+	}
+
+	if len(conflicts) != len(expected) {
+		t.Fatalf("expected %v but %v", expected, conflicts)
+	}
+
+	for i, conflict := range conflicts {
+		if conflict != expected[i] {
+			t.Errorf("test for %d is failed, expected %v but %v",
+				i, expected[i], conflict)
+		}
+	}
+
+	if r := c.ToPascal("wifi_router"); r != "WIFIRouter" {
+		t.Errorf("expected WIFIRouter but %s", r)
+	}
+
+	if r := ToPascal("wifi_router"); r != "WiFiRouter" {
+		t.Errorf("expected WiFiRouter but %s", r)
+	}
+}
+
+// TestLoadAbbreviationsErrors tests validation of the entries.
+func TestLoadAbbreviationsErrors(t *testing.T) {
+	tests := []struct {
+		data   string
+		format DictionaryFormat
+	}{
+		{`{"iOS": "iOS"}`, DictionaryJSON},
+		{`{"ios": "OSX"}`, DictionaryJSON},
+		{`{"graph-ql": "Graph-QL"}`, DictionaryJSON},
+		{`{"ios": "iOS"`, DictionaryJSON},
+		{`["ios", "iOS"]`, DictionaryJSON},
+		{`{"ios": 1}`, DictionaryJSON},
+		{`{"é": "É"}`, DictionaryJSON},
+		{`{"ключ": "КЛЮЧ"}`, DictionaryJSON},
+		{`{"k": "\u212A"}`, DictionaryJSON},
+		{`{"ios": "iOS", "ios": "IOS"}`, DictionaryJSON},
+		{"ios,iOS\ngraphql,GraphQL\nios,IOS\n", DictionaryCSV},
+		{"café,CAFÉ\n", DictionaryCSV},
+		{"42,42\n", DictionaryCSV},
+		{"ios,iOS\noauth,OAuth2\n", DictionaryCSV},
+		{"ios,iOS,mobile\n", DictionaryCSV},
+		{",\n", DictionaryCSV},
+		{"ios,iOS\n", DictionaryFormat(255)},
+	}
+
+	for i, test := range tests {
+		c, _ := NewConverter(WithoutDefaultAbbreviations())
+		_, err := c.LoadAbbreviations(strings.NewReader(test.data), test.format)
+		if err == nil {
+			t.Errorf("test for %d is failed, expected an error", i)
+		}
+
+		if n := len(c.Abbreviations()); n != 0 {
+			t.Errorf("test for %d is failed, dictionary was changed", i)
+		}
+	}
+}
+
+// TestLoadAbbreviationsFS tests LoadAbbreviationsFS function.
+func TestLoadAbbreviationsFS(t *testing.T) {
+	defer ResetAbbreviations()
+
+	fsys := fstest.MapFS{
+		"glossary.json": {Data: []byte(`{"oauth": "OAuth"}`)},
+		"glossary.csv":  {Data: []byte("ios,iOS\n")},
+		"glossary.txt":  {Data: []byte("ios,iOS\n")},
+	}
+
+	if _, err := LoadAbbreviationsFS(fsys, "glossary.json"); err != nil {
+		t.Fatal(err)
+	}
+
+	c, _ := NewConverter()
+	if _, err := c.LoadAbbreviationsFS(fsys, "glossary.csv"); err != nil {
+		t.Fatal(err)
+	}
+
+	if r := ToPascal("oauth_ios"); r != "OAuthIos" {
+		t.Errorf("expected OAuthIos but %s", r)
+	}
+
+	if r := c.ToPascal("oauth_ios"); r != "OauthiOS" {
+		t.Errorf("expected OauthiOS but %s", r)
+	}
+
+	for _, name := range []string{"glossary.txt", "unknown.csv"} {
+		if _, err := c.LoadAbbreviationsFS(fsys, name); err == nil {
+			t.Errorf("there must be an error for %s", name)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package scs
+
+// The mapEntry is a value of the Map with the original spelling
+// of its identifier.
+type mapEntry[V any] struct {
+	name  string
+	value V
+}
+
+// Collision describes a value of the Map that was set under a different
+// spelling of an identifier that was already in the map.
+type Collision struct {
+	Key string // key of the identifier, see the Key function
+	Old string // spelling that was in the map
+	New string // spelling that replaced it
+}
+
+// Map is a map keyed by identifiers regardless of their case style.
+//
+// Identifiers are compared by their keys, see the Key function, so a value
+// stored under "user_id" is found by "userId", "UserID" or "USER_ID". The
+// map remembers the original spelling of each identifier for iteration and
+// reports collisions: when a value is set under a different spelling of an
+// identifier that is already in the map, the value and its spelling are
+// replaced and the collision is reported.
+//
+// The map takes a snapshot of the rules of its converter, including the
+// abbreviations dictionary, when it's created by NewMap or first used,
+// so later changes of the dictionary, such as AddAbbreviation or
+// RemoveAbbreviation, don't change the keys of the stored identifiers.
+//
+// The zero value is an empty map that uses the default converter.
+// Map isn't safe for concurrent use.
+//
+// Example usage:
+//
+//	var config scs.Map[string]
+//	config.Set("USER_ID", "42")     // from the environment
+//	config.Set("user-name", "john") // from flags
+//
+//	config.Get("userId") // 42, true
+//	config.Range(func(name, value string) bool {
+//		fmt.Println(name, value) // USER_ID 42, user-name john
+//		return true
+//	})
+type Map[V any] struct {
+	converter  *Converter
+	entries    map[string]mapEntry[V]
+	keys       []string // keys in the order of insertion
+	collisions []Collision
+}
+
+// NewMap returns a new map that uses the tokenizer of the converter to find
+// keys of identifiers. The default converter is used if the converter is nil.
+// The map uses a snapshot of the converter, see the Map type for details.
+//
+// Example usage:
+//
+//	c, _ := scs.NewConverter(scs.WithNumberPolicy(scs.AttachToPrevious))
+//	m := scs.NewMap[int](c)
+//	m.Set("utf8Decoder", 1)
+//	m.Get("utf8_decoder") // 1, true
+func NewMap[V any](c *Converter) *Map[V] {
+	if c == nil {
+		c = defaultConverter
+	}
+
+	return &Map[V]{converter: c.snapshot()}
+}
+
+// The key returns the key of the identifier. The zero value of the map
+// takes a snapshot of the default converter on first use.
+func (m *Map[V]) key(name string) string {
+	if m.converter == nil {
+		m.converter = defaultConverter.snapshot()
+	}
+
+	return m.converter.Key(name)
+}
+
+// Set stores the value under the identifier.
+//
+// If the map already has the identifier under a different spelling,
+// the value and the spelling are replaced, and the collision is returned
+// with true. The collisions are also available from the Collisions method.
+//
+// Example usage:
+//
+//	var m scs.Map[int]
+//	m.Set("user_id", 1)
+//	collision, ok := m.Set("userId", 2)
+//	// collision: {user_id user_id userId}, ok: true
+func (m *Map[V]) Set(name string, value V) (Collision, bool) {
+	if m.entries == nil {
+		m.entries = make(map[string]mapEntry[V])
+	}
+
+	key := m.key(name)
+	old, ok := m.entries[key]
+	m.entries[key] = mapEntry[V]{name, value}
+	if !ok {
+		m.keys = append(m.keys, key)
+		return Collision{}, false
+	}
+
+	if old.name == name {
+		return Collision{}, false
+	}
+
+	collision := Collision{Key: key, Old: old.name, New: name}
+	m.collisions = append(m.collisions, collision)
+	return collision, true
+}
+
+// Get returns the value stored under any spelling of the identifier.
+func (m *Map[V]) Get(name string) (V, bool) {
+	e, ok := m.entries[m.key(name)]
+	return e.value, ok
+}
+
+// Name returns the original spelling of the identifier in the map.
+func (m *Map[V]) Name(name string) (string, bool) {
+	e, ok := m.entries[m.key(name)]
+	return e.name, ok
+}
+
+// Delete removes the value stored under any spelling of the identifier.
+// It returns false if the identifier isn't in the map.
+func (m *Map[V]) Delete(name string) bool {
+	key := m.key(name)
+	if _, ok := m.entries[key]; !ok {
+		return false
+	}
+
+	delete(m.entries, key)
+	for i, k := range m.keys {
+		if k == key {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of identifiers in the map.
+func (m *Map[V]) Len() int {
+	return len(m.entries)
+}
+
+// Range calls the function for each identifier in the order of insertion
+// with its original spelling and value. If the function returns false,
+// the iteration stops.
+func (m *Map[V]) Range(fn func(name string, value V) bool) {
+	for _, key := range append([]string(nil), m.keys...) {
+		e, ok := m.entries[key]
+		if ok && !fn(e.name, e.value) {
+			return
+		}
+	}
+}
+
+// Merge sets all values of the other map in the order of their insertion
+// and returns the collisions. It can be used to merge configurations from
+// several sources, where later sources override earlier ones.
+//
+// Example usage:
+//
+//	env.Merge(flags) // values of flags override values of env
+func (m *Map[V]) Merge(other *Map[V]) []Collision {
+	var collisions []Collision
+	other.Range(func(name string, value V) bool {
+		if collision, ok := m.Set(name, value); ok {
+			collisions = append(collisions, collision)
+		}
+
+		return true
+	})
+
+	return collisions
+}
+
+// Collisions returns all collisions reported by the Set and Merge methods
+// in the order they occurred.
+func (m *Map[V]) Collisions() []Collision {
+	return append([]Collision(nil), m.collisions...)
+}
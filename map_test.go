@@ -0,0 +1,150 @@
+package scs
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestMap tests Get, Set, Delete and Range methods of the Map.
+func TestMap(t *testing.T) {
+	var m Map[int]
+	if _, ok := m.Get("user_id"); ok {
+		t.Error("empty map must not have values")
+	}
+
+	m.Set("user_id", 1)
+	m.Set("HTTPServer", 2)
+	m.Set("max-conns", 3)
+
+	for _, name := range []string{"userId", "UserID", "USER_ID", "user-id"} {
+		if v, ok := m.Get(name); !ok || v != 1 {
+			t.Errorf("expected 1 for %s but %d", name, v)
+		}
+	}
+
+	if _, ok := m.Get("userid"); ok {
+		t.Error("userid is a different identifier")
+	}
+
+	if name, _ := m.Name("httpServer"); name != "HTTPServer" {
+		t.Errorf("expected HTTPServer but %s", name)
+	}
+
+	if !m.Delete("MaxConns") || m.Delete("MaxConns") {
+		t.Error("unexpected result of Delete")
+	}
+
+	var names []string
+	m.Range(func(name string, value int) bool {
+		names = append(names, name)
+		return true
+	})
+
+	expected := []string{"user_id", "HTTPServer"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v but %v", expected, names)
+	}
+
+	// The iteration stops when the function returns false.
+	names = nil
+	m.Range(func(name string, value int) bool {
+		names = append(names, name)
+		return false
+	})
+
+	if len(names) != 1 || m.Len() != 2 {
+		t.Errorf("unexpected iteration %v", names)
+	}
+}
+
+// TestMapCollisions tests collisions of the identifiers.
+func TestMapCollisions(t *testing.T) {
+	var m Map[string]
+	m.Set("USER_ID", "env")
+	if _, ok := m.Set("USER_ID", "env2"); ok {
+		t.Error("the same spelling isn't a collision")
+	}
+
+	collision, ok := m.Set("user-id", "flag")
+	if expected := (Collision{"user_id", "USER_ID", "user-id"}); !ok ||
+		collision != expected {
+		t.Errorf("expected %v but %v", expected, collision)
+	}
+
+	if v, _ := m.Get("userId"); v != "flag" {
+		t.Errorf("expected flag but %s", v)
+	}
+
+	if name, _ := m.Name("userId"); name != "user-id" {
+		t.Errorf("expected user-id but %s", name)
+	}
+
+	if n := len(m.Collisions()); n != 1 || m.Len() != 1 {
+		t.Errorf("expected 1 collision but %d", n)
+	}
+}
+
+// TestMapMerge tests Merge method of the Map.
+func TestMapMerge(t *testing.T) {
+	env := NewMap[string](nil)
+	env.Set("DB_HOST", "localhost")
+	env.Set("DB_PORT", "5432")
+
+	json := NewMap[string](nil)
+	json.Set("dbPort", "6432")
+	json.Set("dbName", "app")
+
+	collisions := env.Merge(json)
+	expected := []Collision{{"db_port", "DB_PORT", "dbPort"}}
+	if !reflect.DeepEqual(collisions, expected) {
+		t.Errorf("expected %v but %v", expected, collisions)
+	}
+
+	if v, _ := env.Get("db-port"); v != "6432" || env.Len() != 3 {
+		t.Errorf("expected 6432 but %s", v)
+	}
+
+	if !reflect.DeepEqual(env.Collisions(), expected) {
+		t.Errorf("expected %v but %v", expected, env.Collisions())
+	}
+}
+
+// TestNewMap tests the Map with the rules of the converter.
+func TestNewMap(t *testing.T) {
+	c, _ := NewConverter(WithNumberPolicy(AttachToPrevious))
+	m := NewMap[int](c)
+	m.Set("utf8Decoder", 1)
+
+	if v, ok := m.Get("utf8_decoder"); !ok || v != 1 {
+		t.Errorf("expected 1 but %d", v)
+	}
+}
+
+// TestMapDictionaryChanges tests that changes of the dictionary
+// don't change the keys of the stored identifiers.
+func TestMapDictionaryChanges(t *testing.T) {
+	defer ResetAbbreviations()
+
+	var m Map[int]
+	m.Set("WiFi", 1)
+
+	c, _ := NewConverter()
+	n := NewMap[int](c)
+	n.Set("WiFi", 2)
+
+	RemoveAbbreviation("wifi")
+	c.RemoveAbbreviation("wifi")
+	if _, err := LoadAbbreviations(strings.NewReader("wi,WI\n"),
+		DictionaryCSV); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok := m.Get("WiFi"); !ok || v != 1 {
+		t.Errorf("expected 1 but %d", v)
+	}
+
+	if v, ok := n.Get("WiFi"); !ok || v != 2 {
+		t.Errorf("expected 2 but %d", v)
+	}
+}
@@ -0,0 +1,166 @@
+package scs
+
+import "strings"
+
+// Name is an identifier parsed into words, which can be rendered
+// in any case style.
+//
+// Name keeps the words in their natural spelling: abbreviations are written
+// according to the acronym policy of the converter that parsed the name,
+// such as "HTTPS" or "iOS", and all other words are written in lower case.
+// Each word is marked as an acronym if it is found in the abbreviations
+// dictionary. Since the words are parsed once, the name is rendered into
+// every style without repeated parsing and without losing the spelling
+// of abbreviations.
+//
+// Name is immutable: its methods never change the name, and the slices
+// returned by them are copies. The zero value is an empty name.
+//
+// Example usage:
+//
+//	name := scs.ParseName("getHTTPSUrl")
+//	name.Snake()  // get_https_url
+//	name.Pascal() // GetHTTPSURL
+//	name.Kebab()  // get-https-url
+type Name struct {
+	words    []string // words in natural spelling
+	acronyms []bool   // true if the word is an abbreviation
+}
+
+// ParseName parses a string of any style into a name using the default
+// converter. See the Name type for details.
+//
+// Example usage:
+//
+//	name := scs.ParseName("user_ids")
+//	name.Words()  // [user IDs]
+//	name.Camel()  // userIDs
+func ParseName(s string) Name {
+	return defaultConverter.ParseName(s)
+}
+
+// ParseName parses a string of any style into a name using the rules
+// of the converter. See the Name type for details.
+//
+// Example usage:
+//
+//	c, _ := scs.NewConverter(scs.WithAcronymPolicy(scs.TitleOnly))
+//	c.ParseName("http_server").Pascal() // HttpServer
+func (c *Converter) ParseName(s string) Name {
+	chunks := c.Words(s)
+	acronyms := make([]bool, len(chunks))
+	for i, chunk := range chunks {
+		acronyms[i] = c.abbreviations.has(chunk)
+	}
+
+	return Name{words: c.spell(chunks), acronyms: acronyms}
+}
+
+// The concat returns a new name with the words of both names.
+func (n Name) concat(m Name) Name {
+	return Name{
+		words:    append(n.Words(), m.words...),
+		acronyms: append(n.Acronyms(), m.acronyms...),
+	}
+}
+
+// Len returns the number of words of the name.
+func (n Name) Len() int {
+	return len(n.words)
+}
+
+// Words returns a copy of the words of the name in natural spelling.
+func (n Name) Words() []string {
+	return append([]string(nil), n.words...)
+}
+
+// Acronyms returns a copy of the acronym flags of the words of the name.
+// The flag of a word is true if the word is an abbreviation.
+func (n Name) Acronyms() []bool {
+	return append([]bool(nil), n.acronyms...)
+}
+
+// IsAcronym returns true if the i-th word of the name is an abbreviation.
+// It returns false if the index is out of range.
+func (n Name) IsAcronym(i int) bool {
+	return i >= 0 && i < len(n.acronyms) && n.acronyms[i]
+}
+
+// String returns the words of the name separated by spaces.
+func (n Name) String() string {
+	return strings.Join(n.words, " ")
+}
+
+// Format renders the name in the style. The style can be any of the
+// built-in styles or a style added by the Register function.
+// It returns an error wrapping ErrInvalidStyle if the style isn't
+// registered.
+//
+// Example usage:
+//
+//	name := scs.ParseName("http server")
+//	value, err := name.Format(scs.Train)
+//	// value: "HTTP-Server", err: nil
+func (n Name) Format(style CaseStyle) (string, error) {
+	e, ok := lookup(style)
+	if !ok {
+		return "", invalidStyleError(n.String(), style)
+	}
+
+	if len(n.words) == 0 {
+		return "", nil
+	}
+
+	return e.style.Join(n.Words()), nil
+}
+
+// The render renders the name in the built-in style.
+func (n Name) render(style CaseStyle) string {
+	value, _ := n.Format(style)
+	return value
+}
+
+// Camel renders the name in camelCase.
+func (n Name) Camel() string {
+	return n.render(Camel)
+}
+
+// Kebab renders the name in kebab-case.
+func (n Name) Kebab() string {
+	return n.render(Kebab)
+}
+
+// Pascal renders the name in PascalCase.
+func (n Name) Pascal() string {
+	return n.render(Pascal)
+}
+
+// Snake renders the name in snake_case.
+func (n Name) Snake() string {
+	return n.render(Snake)
+}
+
+// ScreamingSnake renders the name in SCREAMING_SNAKE_CASE.
+func (n Name) ScreamingSnake() string {
+	return n.render(ScreamingSnake)
+}
+
+// Train renders the name in Train-Case.
+func (n Name) Train() string {
+	return n.render(Train)
+}
+
+// Dot renders the name in dot.case.
+func (n Name) Dot() string {
+	return n.render(Dot)
+}
+
+// Path renders the name in path/case.
+func (n Name) Path() string {
+	return n.render(Path)
+}
+
+// Backslash renders the name in Backslash\Case.
+func (n Name) Backslash() string {
+	return n.render(Backslash)
+}
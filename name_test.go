@@ -0,0 +1,106 @@
+package scs
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParseName tests ParseName function and rendering of the name.
+func TestParseName(t *testing.T) {
+	tests := []struct {
+		value  string
+		camel  string
+		pascal string
+		snake  string
+		kebab  string
+		train  string
+	}{
+		{"getHTTPSUrl", "getHTTPSURL", "GetHTTPSURL", "get_https_url",
+			"get-https-url", "Get-HTTPS-URL"},
+		{"HTTPS", "https", "HTTPS", "https", "https", "HTTPS"},
+		{"user_ids", "userIDs", "UserIDs", "user_ids", "user-ids", "User-IDs"},
+		{"Hello World", "helloWorld", "HelloWorld", "hello_world",
+			"hello-world", "Hello-World"},
+		{"api_key", "apiKey", "APIKey", "api_key", "api-key", "API-Key"},
+		{"", "", "", "", "", ""},
+	}
+
+	for i, test := range tests {
+		name := ParseName(test.value)
+		results := []struct{ expected, result string }{
+			{test.camel, name.Camel()},
+			{test.pascal, name.Pascal()},
+			{test.snake, name.Snake()},
+			{test.kebab, name.Kebab()},
+			{test.train, name.Train()},
+		}
+
+		for _, r := range results {
+			if r.result != r.expected {
+				t.Errorf("test for %d is failed, expected %s but %s",
+					i, r.expected, r.result)
+			}
+		}
+	}
+}
+
+// TestNameLossless tests that the name keeps the spelling
+// of abbreviations in every style.
+func TestNameLossless(t *testing.T) {
+	name := ParseName("HTTPS_SERVER")
+	for _, e := range entries() {
+		value, err := name.Format(e.flag)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r := ParseName(value).Pascal(); e.flag != adaCase &&
+			r != "HTTPSServer" {
+			t.Errorf("%s: expected HTTPSServer but %s (%s)", e.name, r, value)
+		}
+	}
+
+	if _, err := name.Format(0); !errors.Is(err, ErrInvalidStyle) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+// TestNameImmutable tests that the name can't be changed.
+func TestNameImmutable(t *testing.T) {
+	name := ParseName("http server")
+	if name.Len() != 2 || !name.IsAcronym(0) || name.IsAcronym(1) ||
+		name.IsAcronym(2) {
+		t.Errorf("unexpected name %s %v", name, name.Acronyms())
+	}
+
+	words := name.Words()
+	words[0] = "changed"
+	acronyms := name.Acronyms()
+	acronyms[0] = false
+
+	if r := name.String(); r != "HTTP server" {
+		t.Errorf("expected HTTP server but %s", r)
+	}
+
+	if !name.IsAcronym(0) {
+		t.Error("the flag of the name was changed")
+	}
+}
+
+// TestConverterParseName tests ParseName method of the converter.
+func TestConverterParseName(t *testing.T) {
+	c, _ := NewConverter(WithAcronymPolicy(TitleOnly))
+	name := c.ParseName("http_server")
+	if r := name.Pascal(); r != "HttpServer" {
+		t.Errorf("expected HttpServer but %s", r)
+	}
+
+	if !name.IsAcronym(0) {
+		t.Error("expected HTTP to be an acronym")
+	}
+
+	obj, _ := New(Snake, "https server")
+	if r := obj.Name().Pascal(); r != "HTTPSServer" {
+		t.Errorf("expected HTTPSServer but %s", r)
+	}
+}
@@ -0,0 +1,210 @@
+package scs
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// The shortNames contains the canonical names of the built-in styles
+// used by the String method of the CaseStyle.
+var shortNames = map[CaseStyle]string{
+	Camel:          "camel",
+	Kebab:          "kebab",
+	Pascal:         "pascal",
+	Snake:          "snake",
+	ScreamingSnake: "screaming-snake",
+	Train:          "train",
+	Dot:            "dot",
+	Path:           "path",
+	Backslash:      "backslash",
+}
+
+// The aliases contains alternative names of the built-in styles,
+// normalized by the normalizeName function. Canonical and registered
+// names are recognized without aliases.
+var aliases = map[string]CaseStyle{
+	"lowercamel":   Camel,
+	"uppercamel":   Pascal,
+	"lisp":         Kebab,
+	"spinal":       Kebab,
+	"dash":         Kebab,
+	"underscore":   Snake,
+	"uppersnake":   ScreamingSnake,
+	"constant":     ScreamingSnake,
+	"macro":        ScreamingSnake,
+	"httpheader":   Train,
+	"header":       Train,
+	"dotted":       Dot,
+	"slash":        Path,
+	"namespace":    Backslash,
+	"phpnamespace": Backslash,
+}
+
+// The normalizeName converts a name of a style to the lowercase letters
+// and digits without the "case" suffix, so "SCREAMING_SNAKE_CASE",
+// "screaming-snake" and "ScreamingSnake" have the same form.
+func normalizeName(name string) string {
+	var sb strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	if n := sb.String(); n != "case" {
+		return strings.TrimSuffix(n, "case")
+	}
+
+	return sb.String()
+}
+
+// String returns the name of the style. The styles of a combined value
+// are joined with the "|" character, such as "camel|snake". The built-in
+// styles have short names, the styles added by the Register function
+// use their registered names. Zero value is "none".
+//
+// Example usage:
+//
+//	scs.Snake.String()               // snake
+//	(scs.Camel | scs.Snake).String() // camel|snake
+//	scs.CaseStyle(0).String()        // none
+func (c CaseStyle) String() string {
+	if c == 0 {
+		return "none"
+	}
+
+	var names []string
+	rest := c
+	for _, e := range entries() {
+		if c&e.flag == 0 {
+			continue
+		}
+
+		name, ok := shortNames[e.flag]
+		if !ok {
+			name = e.name
+		}
+
+		names = append(names, name)
+		rest &^= e.flag
+	}
+
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("CaseStyle(%d)", uint32(rest)))
+	}
+
+	return strings.Join(names, "|")
+}
+
+// ParseCaseStyle returns the style by its name.
+//
+// The name is case-insensitive, delimiters and the "case" suffix are
+// ignored, so "snake", "snake_case", "SnakeCase" and "SNAKE-CASE" are
+// the same style. The function accepts the names returned by the String
+// method, the names used by the registry (e.g. "camelCase", "kebab-case",
+// "SCREAMING_SNAKE_CASE"), the registered names of custom styles and
+// common aliases such as "constant" or "lowerCamel". Several names joined
+// with the "|" character are combined into a single value. The "none"
+// name is zero.
+//
+// Five of the rename_all names of the serde library are supported:
+// "camelCase", "PascalCase", "snake_case", "SCREAMING_SNAKE_CASE" and
+// "kebab-case". The "lowercase", "UPPERCASE" and "SCREAMING-KEBAB-CASE"
+// names are rejected, since the package has no equivalent styles.
+//
+// An error wrapping ErrInvalidStyle is returned for unknown names.
+//
+// Example usage:
+//
+//	style, err := scs.ParseCaseStyle("kebab-case")
+//	// style: scs.Kebab, err: nil
+//
+//	style, err = scs.ParseCaseStyle("camel|snake")
+//	// style: scs.Camel | scs.Snake, err: nil
+//
+//	style, err = scs.ParseCaseStyle("lowercase")
+//	// style: 0, err: error (incorrect case style)
+func ParseCaseStyle(s string) (CaseStyle, error) {
+	if normalizeName(s) == "none" {
+		return 0, nil
+	}
+
+	var style CaseStyle
+	for _, part := range strings.Split(s, "|") {
+		flag, ok := parseName(part)
+		if !ok {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidStyle, part)
+		}
+
+		style |= flag
+	}
+
+	return style, nil
+}
+
+// The parseName returns the style by its single name.
+func parseName(name string) (CaseStyle, bool) {
+	name = normalizeName(name)
+	if name == "" {
+		return 0, false
+	}
+
+	for _, e := range entries() {
+		if name == normalizeName(e.name) ||
+			name == normalizeName(shortNames[e.flag]) {
+			return e.flag, true
+		}
+	}
+
+	style, ok := aliases[name]
+	return style, ok
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns an error if the value contains unregistered styles.
+func (c CaseStyle) MarshalText() ([]byte, error) {
+	rest := c
+	for _, e := range entries() {
+		rest &^= e.flag
+	}
+
+	if rest != 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidStyle, uint32(c))
+	}
+
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts any name supported by the ParseCaseStyle function.
+//
+// Example usage:
+//
+//	var config struct {
+//		Style scs.CaseStyle `json:"style"`
+//	}
+//
+//	json.Unmarshal([]byte(`{"style": "kebab-case"}`), &config)
+//	// config.Style: scs.Kebab
+func (c *CaseStyle) UnmarshalText(text []byte) error {
+	style, err := ParseCaseStyle(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = style
+	return nil
+}
+
+// Set implements the flag.Value interface.
+// It accepts any name supported by the ParseCaseStyle function.
+//
+// Example usage:
+//
+//	style := scs.Snake
+//	flag.Var(&style, "case", "case style of the output")
+//	flag.Parse() // -case=kebab
+func (c *CaseStyle) Set(s string) error {
+	return c.UnmarshalText([]byte(s))
+}
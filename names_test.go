@@ -0,0 +1,143 @@
+package scs
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"testing"
+)
+
+// TestCaseStyleString tests String method of the CaseStyle.
+func TestCaseStyleString(t *testing.T) {
+	tests := []struct {
+		style  CaseStyle
+		result string
+	}{
+		{Camel, "camel"},
+		{ScreamingSnake, "screaming-snake"},
+		{Backslash, "backslash"},
+		{Camel | Snake, "camel|snake"},
+		{Snake | Camel | Kebab, "camel|kebab|snake"},
+		{adaCase, "Ada_Case"},
+		{0, "none"},
+		{1 << 31, "CaseStyle(2147483648)"},
+	}
+
+	for i, test := range tests {
+		if r := test.style.String(); r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+}
+
+// TestParseCaseStyle tests ParseCaseStyle function.
+func TestParseCaseStyle(t *testing.T) {
+	tests := []struct {
+		value  string
+		result CaseStyle
+		err    bool
+	}{
+		{"camel", Camel, false},
+		{"camelCase", Camel, false},
+		{"lowerCamelCase", Camel, false},
+		{"PascalCase", Pascal, false},
+		{"snake_case", Snake, false},
+		{"kebab-case", Kebab, false},
+		{"SCREAMING_SNAKE_CASE", ScreamingSnake, false},
+		{"screaming-snake", ScreamingSnake, false},
+		{"CONSTANT_CASE", ScreamingSnake, false},
+		{"Train-Case", Train, false},
+		{"dot.case", Dot, false},
+		{"path/case", Path, false},
+		{`Backslash\Case`, Backslash, false},
+		{"Ada_Case", adaCase, false},
+		{"camel|snake", Camel | Snake, false},
+		{" camel | snake ", Camel | Snake, false},
+		{"none", 0, false},
+		{"lowercase", 0, true},
+		{"UPPERCASE", 0, true},
+		{"SCREAMING-KEBAB-CASE", 0, true},
+		{"case", 0, true},
+		{"camel|", 0, true},
+		{"", 0, true},
+	}
+
+	for i, test := range tests {
+		r, err := ParseCaseStyle(test.value)
+		if (err != nil) != test.err {
+			t.Errorf("test for %d is failed, unexpected error %v", i, err)
+		}
+
+		if err != nil && !errors.Is(err, ErrInvalidStyle) {
+			t.Errorf("test for %d is failed, expected ErrInvalidStyle", i)
+		}
+
+		if r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+
+	// The result of the String method is parsed back.
+	for _, e := range entries() {
+		if r, _ := ParseCaseStyle(e.flag.String()); r != e.flag {
+			t.Errorf("expected %s but %s", e.flag, r)
+		}
+	}
+}
+
+// TestCaseStyleText tests the text marshalling of the CaseStyle.
+func TestCaseStyleText(t *testing.T) {
+	var config struct {
+		Input  CaseStyle `json:"input"`
+		Output CaseStyle `json:"output"`
+	}
+
+	data := `{"input": "camelCase|snake_case", "output": "kebab-case"}`
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	if config.Input != Camel|Snake || config.Output != Kebab {
+		t.Errorf("unexpected config %v", config)
+	}
+
+	r, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"input":"camel|snake","output":"kebab"}`; string(r) != expected {
+		t.Errorf("expected %s but %s", expected, r)
+	}
+
+	if err := json.Unmarshal([]byte(`{"input": "upper"}`), &config); err == nil {
+		t.Error("there must be an error for unknown style")
+	}
+
+	if _, err := CaseStyle(1 << 31).MarshalText(); err == nil {
+		t.Error("there must be an error for unregistered style")
+	}
+}
+
+// TestCaseStyleFlag tests the CaseStyle as a flag.Value.
+func TestCaseStyleFlag(t *testing.T) {
+	style := Snake
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&style, "case", "case style")
+
+	if err := fs.Parse([]string{"-case", "Train-Case"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if style != Train {
+		t.Errorf("expected %s but %s", Train, style)
+	}
+
+	if err := fs.Parse([]string{"-case", "unknown"}); err == nil {
+		t.Error("there must be an error for unknown style")
+	}
+}
@@ -1,17 +1,8 @@
 package scs
 
-import (
-	"fmt"
-	"regexp"
-	"strings"
-)
+import "regexp"
 
-var (
-	pascalPrep   = regexp.MustCompile("([0-9]+)")
-	pascalHead   = regexp.MustCompile("(.)([A-Z][a-z]+)")
-	pascalBody   = regexp.MustCompile("([a-z0-9])([A-Z])")
-	isPascalCase = regexp.MustCompile(`^[A-Z]+((\d)|([A-Za-z0-9]+))*([A-Z])?$`)
-)
+var isPascalCase = regexp.MustCompile(`^[A-Z]+((\d)|([A-Za-z0-9]+))*([A-Z])?$`)
 
 // StrIsPascal returns true if the string is in PascalCase.
 //
@@ -36,16 +27,17 @@ func StrIsPascal(s string) bool {
 // including the first word, is capitalized, and there are no underscores
 // or spaces between words.
 //
-// Note that this function will convert all characters to lowercase, except
-// for the first letter of each word, which will be capitalized.
+// The words of mixed-case identifiers are split at capital letters, and
+// runs of capitals are split at the abbreviations they contain.
 //
 // Example usage:
 //
-//	scs.StrToPascal("hello_world") // returns "HelloWorld"
-//	scs.StrToPascal("hello world") // returns "HelloWorld"
-//	scs.StrToPascal("helloWorld")  // returns "Helloworld"
+//	scs.StrToPascal("hello_world")     // returns "HelloWorld"
+//	scs.StrToPascal("hello world")     // returns "HelloWorld"
+//	scs.StrToPascal("helloWorld")      // returns "HelloWorld"
+//	scs.StrToPascal("xml_httpRequest") // returns "XMLHTTPRequest"
 func StrToPascal(s string) string {
-	return toUnited(s, false)
+	return defaultConverter.StrToPascal(s)
 }
 
 // ToPascal converts a string to PascalCase.
@@ -65,21 +57,7 @@ func StrToPascal(s string) string {
 //	scs.ToPascal("helloWorld")    // returns "HelloWorld"
 //	scs.ToPascal("helloWorld123") // returns "HelloWorld123"
 func ToPascal(s string) string {
-	switch {
-	case StrIsCamel(s):
-		r, _ := CamelToPascal(s)
-		return r
-	case StrIsKebab(s):
-		r, _ := KebabToPascal(s)
-		return r
-	case StrIsPascal(s):
-		return s
-	case StrIsSnake(s):
-		r, _ := SnakeToPascal(s)
-		return r
-	}
-
-	return StrToPascal(s)
+	return defaultConverter.ToPascal(s)
 }
 
 // PascalToKebab converts a PascalCase-style string to kebab-case.
@@ -87,9 +65,10 @@ func ToPascal(s string) string {
 // This function checks if the input string is in PascalCase. If it's not,
 // it returns an error.
 //
-// If the input string is in PascalCase, it performs the conversion by
-// inserting hyphens before each capital letter (except the first one)
-// and then converts the string to lowercase.
+// If the input string is in PascalCase, it is split into words by the
+// tokenizer, which keeps abbreviations and numbers as separate words
+// ("HTTPServer" -> "HTTP", "Server"), and the words are joined with
+// hyphens in lower case.
 //
 // Note that this conversion could fail if the input string is not in
 // PascalCase style. In that case, an error will be returned along with
@@ -103,14 +82,7 @@ func ToPascal(s string) string {
 //	result, err := PascalToKebab("helloWorld")
 //	// result: "", err: error (not PascalCase)
 func PascalToKebab(pascal string) (string, error) {
-	if !StrIsPascal(pascal) {
-		return "", fmt.Errorf("value %s isn't PascalCase style", pascal)
-	}
-
-	kebab := pascalPrep.ReplaceAllString(pascal, "-${1}-")
-	kebab = pascalHead.ReplaceAllString(kebab, "${1}-${2}")
-	kebab = pascalBody.ReplaceAllString(kebab, "${1}-${2}")
-	return strings.ToLower(strings.Trim(kebab, "-")), nil
+	return defaultConverter.convert(pascal, Pascal, Kebab)
 }
 
 // PascalToCamel converts a PascalCase-style string to camelCase.
@@ -118,9 +90,10 @@ func PascalToKebab(pascal string) (string, error) {
 // This function checks if the input string is in PascalCase. If it's not,
 // it returns an error.
 //
-// If the input string is in PascalCase, it performs the conversion by
-// inserting a space before each capital letter (except the first one),
-// and then converts the string to camelCase.
+// If the input string is in PascalCase, it is split into words by the
+// tokenizer, the first word is written in lower case and the others are
+// capitalized, with abbreviations spelled as in the dictionary
+// ("HTTPServerID" -> "httpServerID").
 //
 // Note that this conversion could fail if the input string is not in
 // PascalCase style. In that case, an error will be returned along with
@@ -134,13 +107,7 @@ func PascalToKebab(pascal string) (string, error) {
 //	result, err := PascalToCamel("helloWorld")
 //	// result: "", err: error (not PascalCase)
 func PascalToCamel(pascal string) (string, error) {
-	if !StrIsPascal(pascal) {
-		return "", fmt.Errorf("value %s isn't PascalCase style", pascal)
-	}
-
-	camel := pascalHead.ReplaceAllString(pascal, "${1} ${2}")
-	camel = pascalBody.ReplaceAllString(camel, "${1} ${2}")
-	return StrToCamel(camel), nil
+	return defaultConverter.convert(pascal, Pascal, Camel)
 }
 
 // PascalToSnake converts a PascalCase-style string to snake_case.
@@ -148,9 +115,10 @@ func PascalToCamel(pascal string) (string, error) {
 // This function checks if the input string is in PascalCase. If it's not,
 // it returns an error.
 //
-// If the input string is in PascalCase, it performs the conversion by
-// inserting underscores before each capital letter (except the first one),
-// and then converts the string to snake_case.
+// If the input string is in PascalCase, it is split into words by the
+// tokenizer, so runs of capitals are split into known abbreviations
+// ("XMLHTTPRequest" -> "XML", "HTTP", "Request"), and the words are
+// joined with underscores in lower case.
 //
 // Note that this conversion could fail if the input string is not in
 // PascalCase style. In that case, an error will be returned along with
@@ -164,12 +132,74 @@ func PascalToCamel(pascal string) (string, error) {
 //	result, err := PascalToSnake("helloWorld")
 //	// result: "", err: error (not PascalCase)
 func PascalToSnake(pascal string) (string, error) {
-	if !StrIsPascal(pascal) {
-		return "", fmt.Errorf("value %s isn't PascalCase style", pascal)
-	}
+	return defaultConverter.convert(pascal, Pascal, Snake)
+}
+
+// PascalToScreamingSnake converts a PascalCase-style string
+// to SCREAMING_SNAKE_CASE.
+//
+// This function checks if the input string is in PascalCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := PascalToScreamingSnake("HelloWorld")
+//	// result: "HELLO_WORLD", err: nil
+//
+//	result, err := PascalToScreamingSnake("helloWorld")
+//	// result: "", err: error (not PascalCase)
+func PascalToScreamingSnake(pascal string) (string, error) {
+	return defaultConverter.convert(pascal, Pascal, ScreamingSnake)
+}
+
+// PascalToTrain converts a PascalCase-style string to Train-Case.
+//
+// This function checks if the input string is in PascalCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := PascalToTrain("XRequestID")
+//	// result: "X-Request-ID", err: nil
+func PascalToTrain(pascal string) (string, error) {
+	return defaultConverter.convert(pascal, Pascal, Train)
+}
 
-	snake := pascalPrep.ReplaceAllString(pascal, "_${1}_")
-	snake = pascalHead.ReplaceAllString(snake, "${1}_${2}")
-	snake = pascalBody.ReplaceAllString(snake, "${1}_${2}")
-	return strings.ToLower(strings.Trim(snake, "_")), nil
+// PascalToDot converts a PascalCase-style string to dot.case.
+//
+// This function checks if the input string is in PascalCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := PascalToDot("HelloWorld")
+//	// result: "hello.world", err: nil
+func PascalToDot(pascal string) (string, error) {
+	return defaultConverter.convert(pascal, Pascal, Dot)
+}
+
+// PascalToPath converts a PascalCase-style string to path/case.
+//
+// This function checks if the input string is in PascalCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := PascalToPath("HelloWorld")
+//	// result: "hello/world", err: nil
+func PascalToPath(pascal string) (string, error) {
+	return defaultConverter.convert(pascal, Pascal, Path)
+}
+
+// PascalToBackslash converts a PascalCase-style string to Backslash\Case.
+//
+// This function checks if the input string is in PascalCase. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := PascalToBackslash("HelloWorld")
+//	// result: `Hello\World`, err: nil
+func PascalToBackslash(pascal string) (string, error) {
+	return defaultConverter.convert(pascal, Pascal, Backslash)
 }
@@ -97,7 +97,7 @@ func TestStrToPascalExample(t *testing.T) {
 	}
 
 	result = StrToPascal("helloWorld")
-	expected = "Helloworld"
+	expected = "HelloWorld"
 	if result != expected {
 		t.Errorf("StrToPascal(\"helloWorld\") returned %s, expected %s",
 			result, expected)
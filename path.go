@@ -0,0 +1,136 @@
+package scs
+
+import (
+	"regexp"
+	"strings"
+)
+
+var isPathCase = regexp.MustCompile(`^([a-z0-9]+(/[a-z0-9]+)*|` +
+	`[a-z0-9]+(-[a-z0-9]+)*(/[a-z0-9]+(-[a-z0-9]+)*)+)$`)
+
+// The pathStyle is the path/case style. The segments of a path can be
+// kebab words, so a value that is already a path keeps its segments.
+type pathStyle struct {
+	separateStyle
+}
+
+// The keeps returns true if the value is a path of several segments,
+// which is kept as is.
+func (p pathStyle) keeps(s string) bool {
+	return strings.Contains(s, p.delimiter) && p.Is(s)
+}
+
+// StrIsPath returns true if the string is in path/case.
+//
+// Path case represents words separated by slashes and does not have any
+// capital letters. It is commonly used for routes and file paths, so
+// a segment of the path can consist of several words in kebab-case.
+// A single segment of such words is kebab-case rather than path/case.
+//
+// Example usage:
+//
+//	scs.StrIsPath("users/profile/image") // returns true
+//	scs.StrIsPath("users/profile-image") // returns true
+//	scs.StrIsPath("profile-image")       // returns false
+//	scs.StrIsPath("users/Profile")       // returns false
+//	scs.StrIsPath("/users/profile")      // returns false
+func StrIsPath(s string) bool {
+	return isPathCase.Match([]byte(s))
+}
+
+// StrToPath converts a string to path/case.
+//
+// This function splits the input string into words, converts each word
+// to lower case and joins them with slashes. A string that is already
+// a path of several segments keeps its segments.
+//
+// Example usage:
+//
+//	scs.StrToPath("users profile image") // returns "users/profile/image"
+//	scs.StrToPath("users/profile-image") // returns "users/profile-image"
+//	scs.StrToPath("users_profile_image") // returns "users/profile/image"
+//	scs.StrToPath("Hello World")         // returns "hello/world"
+func StrToPath(s string) string {
+	return defaultConverter.StrToPath(s)
+}
+
+// ToPath converts a string to path/case.
+//
+// Unlike the StrToPath function, if the source string already has a certain
+// format such as camelCase, kebab-case, PascalCase or snake_case, it will
+// be correctly converted to path/case.
+//
+// Example usage:
+//
+//	scs.ToPath("profileImage")  // returns "profile/image"
+//	scs.ToPath("profile-image") // returns "profile/image"
+//	scs.ToPath("ProfileImage")  // returns "profile/image"
+//	scs.ToPath("profile/image") // returns "profile/image"
+//	scs.ToPath("users/profile-image") // returns "users/profile-image"
+func ToPath(s string) string {
+	return defaultConverter.ToPath(s)
+}
+
+// PathToCamel converts a path/case-style string to camelCase.
+//
+// This function checks if the input string is in path/case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := PathToCamel("profile/image")
+//	// result: "profileImage", err: nil
+//
+//	result, err := PathToCamel("Profile/Image")
+//	// result: "", err: error (not path/case)
+func PathToCamel(path string) (string, error) {
+	return defaultConverter.convert(path, Path, Camel)
+}
+
+// PathToKebab converts a path/case-style string to kebab-case.
+//
+// This function checks if the input string is in path/case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := PathToKebab("profile/image")
+//	// result: "profile-image", err: nil
+//
+//	result, err := PathToKebab("Profile/Image")
+//	// result: "", err: error (not path/case)
+func PathToKebab(path string) (string, error) {
+	return defaultConverter.convert(path, Path, Kebab)
+}
+
+// PathToPascal converts a path/case-style string to PascalCase.
+//
+// This function checks if the input string is in path/case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := PathToPascal("profile/image")
+//	// result: "ProfileImage", err: nil
+//
+//	result, err := PathToPascal("Profile/Image")
+//	// result: "", err: error (not path/case)
+func PathToPascal(path string) (string, error) {
+	return defaultConverter.convert(path, Path, Pascal)
+}
+
+// PathToSnake converts a path/case-style string to snake_case.
+//
+// This function checks if the input string is in path/case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := PathToSnake("profile/image")
+//	// result: "profile_image", err: nil
+//
+//	result, err := PathToSnake("Profile/Image")
+//	// result: "", err: error (not path/case)
+func PathToSnake(path string) (string, error) {
+	return defaultConverter.convert(path, Path, Snake)
+}
@@ -0,0 +1,150 @@
+package scs
+
+import "testing"
+
+// TestStrIsPath tests StrIsPath function.
+func TestStrIsPath(t *testing.T) {
+	tests := []struct {
+		value  string
+		result bool
+	}{
+		{"one", true},
+		{"One", false},
+		{"users/profile/image", true},
+		{"users/Profile/Image", false},
+		{"users//image", false},
+		{"/users/image", false},
+		{"ice/9", true},
+		{"users/profile-image", true},
+		{"max-open-conns", false},
+		{"users/-image", false},
+		{"users/profile-", false},
+		{"users/profile--image", false},
+	}
+
+	for _, s := range tests {
+		if r := StrIsPath(s.value); s.result != r {
+			t.Errorf("test for `%s` is failed, "+
+				"expected %t but %t", s.value, s.result, r)
+		}
+	}
+}
+
+// TestStrToPath tests StrToPath function.
+func TestStrToPath(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"One", "one"},
+		{" One two Three ", "one/two/three"},
+		{"Ice 9", "ice/9"},
+		{"users profile image", "users/profile/image"},
+		{"users_profile-image", "users/profile/image"},
+		{"users/profile-image", "users/profile-image"},
+		{"api/v2/user-ids", "api/v2/user-ids"},
+		{"max-open-conns", "max/open/conns"},
+	}
+
+	for i, s := range tests {
+		if r := StrToPath(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestToPath tests ToPath function.
+func TestToPath(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"maxOpenConns", "max/open/conns"},
+		{"max-open-conns", "max/open/conns"},
+		{"MaxOpenConns", "max/open/conns"},
+		{"max_open_conns", "max/open/conns"},
+		{"max/open/conns", "max/open/conns"},
+		{"HTTPToHTTPS", "http/to/https"},
+		{"users/profile-image", "users/profile-image"},
+	}
+
+	for i, s := range tests {
+		if r := ToPath(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestPathTo tests PathTo* and *ToPath functions.
+func TestPathTo(t *testing.T) {
+	tests := []struct {
+		fn     func(string) (string, error)
+		value  string
+		result string
+	}{
+		{PathToCamel, "is/http/or/https", "isHTTPOrHTTPS"},
+		{PathToKebab, "is/http/or/https", "is-http-or-https"},
+		{PathToPascal, "is/http/or/https", "IsHTTPOrHTTPS"},
+		{PathToSnake, "is/http/or/https", "is_http_or_https"},
+		{CamelToPath, "isHTTPOrHTTPS", "is/http/or/https"},
+		{KebabToPath, "is-http-or-https", "is/http/or/https"},
+		{PascalToPath, "IsHTTPOrHTTPS", "is/http/or/https"},
+		{SnakeToPath, "is_http_or_https", "is/http/or/https"},
+		{PathToCamel, "users/profile-image", "usersProfileImage"},
+		{PathToSnake, "users/profile-image", "users_profile_image"},
+	}
+
+	for i, s := range tests {
+		r, err := s.fn(s.value)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r != s.result {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestPathToError tests PathTo* functions with wrong value.
+func TestPathToError(t *testing.T) {
+	notPath := "one_two_three"
+
+	for _, fn := range []func(string) (string, error){
+		PathToCamel,
+		PathToKebab,
+		PathToPascal,
+		PathToSnake,
+	} {
+		if _, err := fn(notPath); err == nil {
+			t.Error("not path converted")
+		}
+	}
+}
+
+// TestPathSegments tests that a path keeps its kebab segments.
+func TestPathSegments(t *testing.T) {
+	obj, err := New(Path, "users/profile-image")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := obj.Value(); v != "users/profile-image" {
+		t.Errorf("expected users/profile-image but %s", v)
+	}
+
+	if err := obj.ToSnake(); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := obj.Value(); v != "users_profile_image" {
+		t.Errorf("expected users_profile_image but %s", v)
+	}
+
+	if r, _ := Convert("users/profile-image", Path); r != "users/profile-image" {
+		t.Errorf("expected users/profile-image but %s", r)
+	}
+}
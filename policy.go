@@ -0,0 +1,101 @@
+package scs
+
+// AcronymPolicy defines how abbreviations are written in the styles
+// in which words start with a capital letter, such as PascalCase,
+// camelCase or Train-Case. The segments of Backslash\Case namespaces
+// are always written as with the TitleOnly policy: "App\Http\Client".
+type AcronymPolicy uint8
+
+const (
+	// UpperAll writes abbreviations as in the dictionary, which is
+	// the default policy: "HTTPServer", "userID". Consecutive
+	// abbreviations are written together: "HTTPSURL".
+	UpperAll AcronymPolicy = iota
+
+	// TitleOnly writes abbreviations as regular words, capitalizing only
+	// their first letter: "HttpServer", "userId". Consecutive
+	// abbreviations remain distinguishable: "HttpsUrl".
+	TitleOnly
+
+	// Preserve keeps abbreviations as written in the input string if they
+	// contain capital letters, such as "XMLHttpRequest", and writes them
+	// as in the dictionary otherwise.
+	Preserve
+)
+
+// WithAcronymPolicy sets the policy that defines how abbreviations
+// are written by the converter.
+//
+// Example usage:
+//
+//	c, _ := scs.NewConverter(scs.WithAcronymPolicy(scs.TitleOnly))
+//	c.ToPascal("http_server") // returns "HttpServer"
+//	c.ToCamel("https_url")    // returns "httpsUrl"
+func WithAcronymPolicy(p AcronymPolicy) Option {
+	return func(cfg *config) {
+		cfg.acronyms = p
+	}
+}
+
+// NumberPolicy defines how numbers inside words are split into words.
+type NumberPolicy uint8
+
+const (
+	// SplitNumbers makes numbers separate words, which is the default
+	// policy: "utf8Decoder" -> "utf_8_decoder", "v1.2" -> "v_1_2".
+	SplitNumbers NumberPolicy = iota
+
+	// AttachToPrevious attaches numbers to the previous word:
+	// "utf8Decoder" -> "utf8_decoder", "v2API" -> "v2_api",
+	// "v1.2" -> "v1_2".
+	AttachToPrevious
+
+	// AttachToNext attaches numbers to the next word:
+	// "web2print" -> "web_2print", "2faCode" -> "2fa_code",
+	// "v1.2" -> "v_1_2".
+	AttachToNext
+)
+
+// WithNumberPolicy sets the policy that defines how numbers inside words
+// are split by the converter. Separators, including dots, always split
+// words, so version-like strings such as "v1.2" are split in the same
+// way by every policy: the number is never attached across a separator.
+//
+// Example usage:
+//
+//	c, _ := scs.NewConverter(scs.WithNumberPolicy(scs.AttachToPrevious))
+//	c.ToSnake("utf8Decoder") // returns "utf8_decoder"
+//	c.ToSnake("v2API")       // returns "v2_api"
+func WithNumberPolicy(p NumberPolicy) Option {
+	return func(cfg *config) {
+		cfg.numbers = p
+	}
+}
+
+// The attachNumbers attaches number tokens to the adjacent words
+// according to the policy.
+func attachNumbers(s string, tokens []Token, p NumberPolicy) []Token {
+	if p == SplitNumbers {
+		return tokens
+	}
+
+	result := make([]Token, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		t, last := tokens[i], len(result)-1
+		switch {
+		case t.Kind != TokenNumber:
+			result = append(result, t)
+		case p == AttachToPrevious && last >= 0 &&
+			result[last].Kind != TokenSeparator:
+			result[last] = merge(s, []Token{result[last], t})
+		case p == AttachToNext && i+1 < len(tokens) &&
+			tokens[i+1].Kind != TokenSeparator:
+			result = append(result, merge(s, tokens[i:i+2]))
+			i++
+		default:
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,175 @@
+package scs
+
+import "testing"
+
+// TestWithAcronymPolicy tests WithAcronymPolicy option.
+func TestWithAcronymPolicy(t *testing.T) {
+	tests := []struct {
+		policy AcronymPolicy
+		value  string
+		pascal string
+		camel  string
+		train  string
+	}{
+		{UpperAll, "http_server", "HTTPServer", "httpServer", "HTTP-Server"},
+		{UpperAll, "https url", "HTTPSURL", "httpsURL", "HTTPS-URL"},
+		{UpperAll, "user_ids", "UserIDs", "userIDs", "User-IDs"},
+		{TitleOnly, "http_server", "HttpServer", "httpServer", "Http-Server"},
+		{TitleOnly, "https url", "HttpsUrl", "httpsUrl", "Https-Url"},
+		{TitleOnly, "user_ids", "UserIds", "userIds", "User-Ids"},
+		{TitleOnly, "ios wifi", "IosWifi", "iosWifi", "Ios-Wifi"},
+		{Preserve, "http_server", "HTTPServer", "httpServer", "HTTP-Server"},
+		{Preserve, "XMLHttpRequest", "XMLHttpRequest", "xmlHttpRequest",
+			"XML-Http-Request"},
+		{Preserve, "Http-Server", "HttpServer", "httpServer", "Http-Server"},
+	}
+
+	for i, test := range tests {
+		c, _ := NewConverter(WithAcronymPolicy(test.policy))
+		if r := c.StrToPascal(test.value); r != test.pascal {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.pascal, r)
+		}
+
+		if r := c.StrToCamel(test.value); r != test.camel {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.camel, r)
+		}
+
+		if r := c.StrToTrain(test.value); r != test.train {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.train, r)
+		}
+	}
+}
+
+// TestConsecutiveAcronyms tests that consecutive abbreviations
+// are converted back to the same words with every policy.
+func TestConsecutiveAcronyms(t *testing.T) {
+	for _, policy := range []AcronymPolicy{UpperAll, TitleOnly, Preserve} {
+		c, _ := NewConverter(WithAcronymPolicy(policy))
+		pascal := c.ToPascal("get_https_url")
+		if r := c.ToSnake(pascal); r != "get_https_url" {
+			t.Errorf("policy %d: expected get_https_url but %s (%s)",
+				policy, r, pascal)
+		}
+	}
+}
+
+// TestObjSetAcronymPolicy tests SetAcronymPolicy method of the object.
+func TestObjSetAcronymPolicy(t *testing.T) {
+	obj, _ := New(Pascal, "http server")
+	if v := obj.SetAcronymPolicy(TitleOnly).Value(); v != "HttpServer" {
+		t.Errorf("expected HttpServer but %s", v)
+	}
+
+	if v := obj.Set("user id").Value(); v != "UserId" {
+		t.Errorf("expected UserId but %s", v)
+	}
+
+	if err := obj.ToTrain(); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := obj.Value(); v != "User-Id" {
+		t.Errorf("expected User-Id but %s", v)
+	}
+
+	if v := obj.SetAcronymPolicy(UpperAll).Value(); v != "User-ID" {
+		t.Errorf("expected User-ID but %s", v)
+	}
+
+	if v := StrToPascal("http server"); v != "HTTPServer" {
+		t.Errorf("expected HTTPServer but %s", v)
+	}
+}
+
+// TestWithNumberPolicy tests WithNumberPolicy option.
+func TestWithNumberPolicy(t *testing.T) {
+	tests := []struct {
+		policy NumberPolicy
+		value  string
+		snake  string
+		pascal string
+	}{
+		{SplitNumbers, "utf8Decoder", "utf_8_decoder", "Utf8Decoder"},
+		{SplitNumbers, "v2API", "v_2_api", "V2API"},
+		{SplitNumbers, "web2print", "web_2_print", "Web2Print"},
+		{SplitNumbers, "v1.2", "v_1_2", "V12"},
+		{AttachToPrevious, "utf8Decoder", "utf8_decoder", "Utf8Decoder"},
+		{AttachToPrevious, "v2API", "v2_api", "V2API"},
+		{AttachToPrevious, "web2print", "web2_print", "Web2Print"},
+		{AttachToPrevious, "v1.2", "v1_2", "V12"},
+		{AttachToPrevious, "2faCode", "2_fa_code", "2FaCode"},
+		{AttachToNext, "utf8Decoder", "utf_8decoder", "Utf8decoder"},
+		{AttachToNext, "web2print", "web_2print", "Web2print"},
+		{AttachToNext, "2faCode", "2fa_code", "2faCode"},
+		{AttachToNext, "v1.2", "v_1_2", "V12"},
+	}
+
+	for i, test := range tests {
+		c, _ := NewConverter(WithNumberPolicy(test.policy))
+		if r := c.StrToSnake(test.value); r != test.snake {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.snake, r)
+		}
+
+		if r := c.StrToPascal(test.value); r != test.pascal {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.pascal, r)
+		}
+
+		// The value converted to PascalCase must be split in the same
+		// way again, unless it lost its separators, as "v1.2" did.
+		if r := c.ToSnake(test.pascal); r != test.snake &&
+			test.value != "v1.2" {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.snake, r)
+		}
+	}
+}
+
+// TestNumberPolicyDictionary tests that abbreviations with digits
+// are kept together with every number policy.
+func TestNumberPolicyDictionary(t *testing.T) {
+	policies := []NumberPolicy{SplitNumbers, AttachToPrevious, AttachToNext}
+	for _, policy := range policies {
+		c, _ := NewConverter(
+			WithNumberPolicy(policy),
+			WithAbbreviations("UTF8"),
+		)
+
+		if r := c.ToSnake("UTF8Decoder"); r != "utf8_decoder" {
+			t.Errorf("policy %d: expected utf8_decoder but %s", policy, r)
+		}
+
+		if r := c.ToPascal("utf8_decoder"); r != "UTF8Decoder" {
+			t.Errorf("policy %d: expected UTF8Decoder but %s", policy, r)
+		}
+	}
+}
+
+// TestObjSetNumberPolicy tests SetNumberPolicy method of the object.
+func TestObjSetNumberPolicy(t *testing.T) {
+	obj, _ := New(Snake, "utf8 decoder")
+	if v := obj.Value(); v != "utf_8_decoder" {
+		t.Errorf("expected utf_8_decoder but %s", v)
+	}
+
+	obj.SetNumberPolicy(AttachToPrevious)
+	if v := obj.Set("utf8 decoder").Value(); v != "utf8_decoder" {
+		t.Errorf("expected utf8_decoder but %s", v)
+	}
+
+	if err := obj.ToCamel(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := obj.ToKebab(); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := obj.Value(); v != "utf8-decoder" {
+		t.Errorf("expected utf8-decoder but %s", v)
+	}
+}
@@ -0,0 +1,48 @@
+package scs
+
+// Profile is a named set of abbreviations that can be used as
+// the abbreviations dictionary of a Converter or a StringCaseStyle.
+type Profile uint8
+
+const (
+	// ProfileExtended is the default profile. It contains a large list
+	// of abbreviations used in IT, networking and everyday writing,
+	// such as "HTTP", "WiFi" or "ASAP".
+	ProfileExtended Profile = iota
+
+	// ProfileGoLint contains exactly the common initialisms of golint,
+	// such as "HTTP", "ID" or "URL", so the names generated for Go code
+	// match the expectations of go vet, golint and staticcheck.
+	ProfileGoLint
+
+	// ProfileNone contains no abbreviations, so all words are written
+	// as regular words.
+	ProfileNone
+)
+
+// The abbreviations returns the abbreviations of the profile.
+func (p Profile) abbreviations() map[string]string {
+	switch p {
+	case ProfileExtended:
+		return defaultAbbreviations
+	case ProfileGoLint:
+		return goLintAbbreviations
+	}
+
+	return nil
+}
+
+// WithProfile creates the converter with the abbreviations dictionary
+// of the profile. The WithAbbreviations option can be used to add more
+// abbreviations to it.
+//
+// Example usage:
+//
+//	c, _ := scs.NewConverter(scs.WithProfile(scs.ProfileGoLint))
+//	c.ToPascal("cat_food_id") // returns "CatFoodID"
+//	c.ToPascal("atm_machine") // returns "AtmMachine"
+func WithProfile(p Profile) Option {
+	return func(cfg *config) {
+		cfg.base = p.abbreviations()
+	}
+}
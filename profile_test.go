@@ -0,0 +1,102 @@
+package scs
+
+import "testing"
+
+// TestWithProfile tests WithProfile option.
+func TestWithProfile(t *testing.T) {
+	tests := []struct {
+		profile Profile
+		value   string
+		result  string
+	}{
+		{ProfileExtended, "cat_food_id", "CATFoodID"},
+		{ProfileExtended, "atm_machine", "ATMMachine"},
+		{ProfileGoLint, "cat_food_id", "CatFoodID"},
+		{ProfileGoLint, "atm_machine", "AtmMachine"},
+		{ProfileGoLint, "http_json_api", "HTTPJSONAPI"},
+		{ProfileNone, "http_json_api", "HttpJsonApi"},
+		{Profile(255), "http_json_api", "HttpJsonApi"},
+	}
+
+	for i, test := range tests {
+		c, err := NewConverter(WithProfile(test.profile))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r := c.ToPascal(test.value); r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+}
+
+// TestProfileGoLint tests that ProfileGoLint contains the golint
+// initialisms only.
+func TestProfileGoLint(t *testing.T) {
+	c, _ := NewConverter(WithProfile(ProfileGoLint))
+	abbrs := c.Abbreviations()
+	if len(abbrs) != len(goLintAbbreviations) {
+		t.Errorf("expected %d abbreviations but %d",
+			len(goLintAbbreviations), len(abbrs))
+	}
+
+	for _, word := range []string{"cat", "atm", "bf", "brb", "afaik"} {
+		if _, ok := abbrs[word]; ok {
+			t.Errorf("unexpected abbreviation %s", word)
+		}
+	}
+
+	c, _ = NewConverter(
+		WithProfile(ProfileGoLint),
+		WithAbbreviations("GraphQL"),
+	)
+	if r := c.ToPascal("graphql_id"); r != "GraphQLID" {
+		t.Errorf("expected GraphQLID but %s", r)
+	}
+}
+
+// TestObjSetProfile tests SetProfile method of the object.
+func TestObjSetProfile(t *testing.T) {
+	obj, _ := New(Pascal, "cat food")
+	if v := obj.Value(); v != "CATFood" {
+		t.Errorf("expected CATFood but %s", v)
+	}
+
+	if v := obj.SetProfile(ProfileGoLint).Value(); v != "CatFood" {
+		t.Errorf("expected CatFood but %s", v)
+	}
+
+	if v := obj.Set("atm machine id").Value(); v != "AtmMachineID" {
+		t.Errorf("expected AtmMachineID but %s", v)
+	}
+
+	if err := obj.ToKebab(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := obj.ToPascal(); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := obj.Value(); v != "AtmMachineID" {
+		t.Errorf("expected AtmMachineID but %s", v)
+	}
+}
+
+// TestConverterNew tests New method of the converter.
+func TestConverterNew(t *testing.T) {
+	c, _ := NewConverter(WithProfile(ProfileGoLint))
+	obj, err := c.New(Pascal, "cat food")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := obj.Value(); v != "CatFood" {
+		t.Errorf("expected CatFood but %s", v)
+	}
+
+	if _, err := c.New(0, "cat food"); err == nil {
+		t.Error("there must be an error for unknown style")
+	}
+}
@@ -0,0 +1,101 @@
+package scs
+
+import (
+	"fmt"
+	"sync"
+)
+
+// The maxStyles is the maximum number of styles that can be registered,
+// since each style occupies one bit of the CaseStyle.
+const maxStyles = 32
+
+// The entry is a registered string case style.
+type entry struct {
+	flag  CaseStyle
+	name  string
+	style Style
+}
+
+// The registry contains all known string case styles in the order
+// of registration. The order defines the priority of styles when
+// the style of a string is detected.
+var registry = struct {
+	sync.RWMutex
+	entries []entry
+}{
+	entries: []entry{
+		{Camel, "camelCase", unitedStyle{isCamelCase, true}},
+		{Kebab, "kebab-case", separateStyle{isKebabCase, "-", false}},
+		{Pascal, "PascalCase", unitedStyle{isPascalCase, false}},
+		{Snake, "snake_case", separateStyle{isSnakeCase, "_", false}},
+		{ScreamingSnake, "SCREAMING_SNAKE_CASE",
+			separateStyle{isScreamingSnakeCase, "_", true}},
+		{Train, "Train-Case", titledStyle{isTrainCase, "-", false}},
+		{Dot, "dot.case", separateStyle{isDotCase, ".", false}},
+		{Path, "path/case", pathStyle{separateStyle{isPathCase, "/", false}}},
+		{Backslash, `Backslash\Case`, titledStyle{isBackslashCase, `\`, true}},
+	},
+}
+
+// Register registers a new string case style under the given name
+// and returns the CaseStyle assigned to it.
+//
+// The returned CaseStyle can be used with the New function and with
+// the CopyTo and To methods of the StringCaseStyle object, so a value
+// of the registered style can be converted to and from any other
+// registered style. Registered styles also take part in the detection
+// of the style performed by the To* functions, after all styles that
+// were registered earlier.
+//
+// The name must be unique. An error wrapping ErrInvalidStyle is returned
+// if the name is empty or already registered, if the style is nil or if
+// the limit of styles has been reached.
+//
+// Example usage:
+//
+//	ada, err := scs.Register("Ada_Case", adaStyle{})
+//	style, _ := scs.New(ada, "hello world")
+//	style.Value() // Hello_World
+func Register(name string, style Style) (CaseStyle, error) {
+	if name == "" || style == nil {
+		return 0, ErrInvalidStyle
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	for _, e := range registry.entries {
+		if e.name == name {
+			return 0, fmt.Errorf("%w: %s is already registered",
+				ErrInvalidStyle, name)
+		}
+	}
+
+	if len(registry.entries) >= maxStyles {
+		return 0, fmt.Errorf("%w: too many case styles", ErrInvalidStyle)
+	}
+
+	flag := CaseStyle(1) << len(registry.entries)
+	registry.entries = append(registry.entries, entry{flag, name, style})
+
+	return flag, nil
+}
+
+// The entries returns registered styles in the order of registration.
+func entries() []entry {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return registry.entries
+}
+
+// The lookup returns the registered style by its flag.
+func lookup(style CaseStyle) (entry, bool) {
+	for _, e := range entries() {
+		if e.flag == style {
+			return e, true
+		}
+	}
+
+	return entry{}, false
+}
@@ -0,0 +1,149 @@
+package scs
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// The adaStyle is Ada_Case style used to test the registry.
+type adaStyle struct{}
+
+var isAdaCase = regexp.MustCompile(`^[A-Z0-9][a-z0-9]*(_[A-Z0-9][a-z0-9]*)*$`)
+
+func (adaStyle) Split(s string) []string {
+	return strings.Split(strings.ToLower(s), "_")
+}
+
+func (adaStyle) Join(words []string) string {
+	titled := make([]string, len(words))
+	for i, word := range words {
+		titled[i] = strings.Title(strings.ToLower(word))
+	}
+
+	return strings.Join(titled, "_")
+}
+
+func (adaStyle) Is(s string) bool {
+	return isAdaCase.MatchString(s)
+}
+
+var adaCase, adaErr = Register("Ada_Case", adaStyle{})
+
+// TestRegister tests Register function.
+func TestRegister(t *testing.T) {
+	if adaErr != nil {
+		t.Fatal(adaErr)
+	}
+
+	if adaCase != Backslash<<1 {
+		t.Errorf("expected %d but %d", Backslash<<1, adaCase)
+	}
+
+	if _, err := Register("Ada_Case", adaStyle{}); err == nil {
+		t.Error("there must be an error for duplicate name")
+	}
+
+	if _, err := Register("", adaStyle{}); err == nil {
+		t.Error("there must be an error for empty name")
+	}
+
+	if _, err := Register("Nil_Case", nil); err == nil {
+		t.Error("there must be an error for nil style")
+	}
+}
+
+// TestRegisteredNew tests New function with a registered style.
+func TestRegisteredNew(t *testing.T) {
+	obj, err := New(adaCase, "http to https")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r := obj.Value(); r != "Http_To_Https" {
+		t.Errorf("expected Http_To_Https but %s", r)
+	}
+
+	tests := []struct {
+		style  CaseStyle
+		result string
+	}{
+		{Camel, "httpToHTTPS"},
+		{Kebab, "http-to-https"},
+		{Pascal, "HTTPToHTTPS"},
+		{ScreamingSnake, "HTTP_TO_HTTPS"},
+		{Train, "HTTP-To-HTTPS"},
+		{Backslash, `Http\To\Https`},
+	}
+
+	for _, test := range tests {
+		copied, err := obj.CopyTo(test.style)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r := copied.Value(); r != test.result {
+			t.Errorf("expected %s but %s", test.result, r)
+		}
+
+		back, err := copied.CopyTo(adaCase)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r := back.Value(); r != "Http_To_Https" {
+			t.Errorf("expected Http_To_Https but %s", r)
+		}
+	}
+}
+
+// TestRegisteredDetection tests To* functions with a registered style.
+func TestRegisteredDetection(t *testing.T) {
+	if r := ToCamel("Max_Open_Conns"); r != "maxOpenConns" {
+		t.Errorf("expected maxOpenConns but %s", r)
+	}
+
+	if r := defaultConverter.toStyle("max-open-conns", adaCase); r != "Max_Open_Conns" {
+		t.Errorf("expected Max_Open_Conns but %s", r)
+	}
+}
+
+// TestConvertStyles tests convert function.
+func TestConvertStyles(t *testing.T) {
+	if _, err := defaultConverter.convert("hello", 0, Camel); err == nil {
+		t.Error("there must be an error for unknown source style")
+	}
+
+	if _, err := defaultConverter.convert("hello", Camel, 0); err == nil {
+		t.Error("there must be an error for unknown target style")
+	}
+
+	_, err := defaultConverter.convert("hello-world", Camel, Kebab)
+	if err == nil || err.Error() != "value hello-world isn't camelCase style" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+// TestObjTo tests CopyTo and To methods of the object.
+func TestObjTo(t *testing.T) {
+	obj, _ := New(Snake, "hello world")
+	if _, err := obj.CopyTo(0); err == nil {
+		t.Error("there must be an error")
+	}
+
+	if err := obj.To(0); err == nil {
+		t.Error("there must be an error")
+	}
+
+	if !obj.IsSnake() || obj.Value() != "hello_world" {
+		t.Error("object must remain unchanged")
+	}
+
+	if err := obj.To(Kebab); err != nil {
+		t.Error(err)
+	}
+
+	if !obj.IsKebab() || obj.Value() != "hello-world" {
+		t.Errorf("expected hello-world but %s", obj.Value())
+	}
+}
@@ -0,0 +1,124 @@
+package scs
+
+import "regexp"
+
+var isScreamingSnakeCase = regexp.MustCompile(
+	"(^[A-Z0-9_]+_[A-Z0-9_]+$)|(^[A-Z0-9]+$)",
+)
+
+// StrIsScreamingSnake returns true if the string is in SCREAMING_SNAKE_CASE.
+//
+// Screaming snake case represents words separated by underscores and does
+// not have any lowercase letters. It is commonly used for constants and
+// environment variable names.
+//
+// Example usage:
+//
+//	scs.StrIsScreamingSnake("HELLO_WORLD") // returns true
+//	scs.StrIsScreamingSnake("hello_world") // returns false
+//	scs.StrIsScreamingSnake("HELLO-WORLD") // returns false
+func StrIsScreamingSnake(s string) bool {
+	return isScreamingSnakeCase.Match([]byte(s))
+}
+
+// StrToScreamingSnake converts a string to SCREAMING_SNAKE_CASE.
+//
+// This function splits the input string into words in the same way as
+// StrToSnake and joins them with underscores, with all letters converted
+// to upper case.
+//
+// Example usage:
+//
+//	scs.StrToScreamingSnake("Hello World") // returns "HELLO_WORLD"
+//	scs.StrToScreamingSnake("hello-world") // returns "HELLO_WORLD"
+//	scs.StrToScreamingSnake("max conns")   // returns "MAX_CONNS"
+func StrToScreamingSnake(s string) string {
+	return defaultConverter.StrToScreamingSnake(s)
+}
+
+// ToScreamingSnake converts a string to SCREAMING_SNAKE_CASE.
+//
+// Unlike the StrToScreamingSnake function, this function attempts to
+// correctly handle strings that are already in a certain format (such as
+// camelCase, kebab-case, PascalCase or snake_case) and convert them into
+// SCREAMING_SNAKE_CASE.
+//
+// Example usage:
+//
+//	scs.ToScreamingSnake("helloWorld")  // returns "HELLO_WORLD"
+//	scs.ToScreamingSnake("HelloWorld")  // returns "HELLO_WORLD"
+//	scs.ToScreamingSnake("hello-world") // returns "HELLO_WORLD"
+//	scs.ToScreamingSnake("HELLO_WORLD") // returns "HELLO_WORLD"
+func ToScreamingSnake(s string) string {
+	return defaultConverter.ToScreamingSnake(s)
+}
+
+// ScreamingSnakeToCamel converts a SCREAMING_SNAKE_CASE-style string
+// to camelCase.
+//
+// This function checks if the input string is in SCREAMING_SNAKE_CASE.
+// If not, it returns an error.
+//
+// Example usage:
+//
+//	scs.ScreamingSnakeToCamel("HELLO_WORLD") // returns "helloWorld", nil
+//	scs.ScreamingSnakeToCamel("hello_world") // returns "", error
+func ScreamingSnakeToCamel(screaming string) (string, error) {
+	return defaultConverter.convert(screaming, ScreamingSnake, Camel)
+}
+
+// ScreamingSnakeToKebab converts a SCREAMING_SNAKE_CASE-style string
+// to kebab-case.
+//
+// This function checks if the input string is in SCREAMING_SNAKE_CASE.
+// If not, it returns an error.
+//
+// Example usage:
+//
+//	scs.ScreamingSnakeToKebab("HELLO_WORLD") // returns "hello-world", nil
+//	scs.ScreamingSnakeToKebab("hello_world") // returns "", error
+func ScreamingSnakeToKebab(screaming string) (string, error) {
+	return defaultConverter.convert(screaming, ScreamingSnake, Kebab)
+}
+
+// ScreamingSnakeToPascal converts a SCREAMING_SNAKE_CASE-style string
+// to PascalCase.
+//
+// This function checks if the input string is in SCREAMING_SNAKE_CASE.
+// If not, it returns an error.
+//
+// Example usage:
+//
+//	scs.ScreamingSnakeToPascal("HELLO_WORLD") // returns "HelloWorld", nil
+//	scs.ScreamingSnakeToPascal("hello_world") // returns "", error
+func ScreamingSnakeToPascal(screaming string) (string, error) {
+	return defaultConverter.convert(screaming, ScreamingSnake, Pascal)
+}
+
+// ScreamingSnakeToSnake converts a SCREAMING_SNAKE_CASE-style string
+// to snake_case.
+//
+// This function checks if the input string is in SCREAMING_SNAKE_CASE.
+// If not, it returns an error.
+//
+// Example usage:
+//
+//	scs.ScreamingSnakeToSnake("HELLO_WORLD") // returns "hello_world", nil
+//	scs.ScreamingSnakeToSnake("HelloWorld")  // returns "", error
+func ScreamingSnakeToSnake(screaming string) (string, error) {
+	return defaultConverter.convert(screaming, ScreamingSnake, Snake)
+}
+
+// ScreamingSnakeToTrain converts a SCREAMING_SNAKE_CASE-style string
+// to Train-Case.
+//
+// This function checks if the input string is in SCREAMING_SNAKE_CASE.
+// If it's not, it returns an error.
+//
+// Example usage:
+//
+//	result, err := ScreamingSnakeToTrain("X_REQUEST_ID")
+//	// result: "X-Request-ID", err: nil
+func ScreamingSnakeToTrain(screaming string) (string, error) {
+	return defaultConverter.convert(screaming, ScreamingSnake, Train)
+}
@@ -0,0 +1,159 @@
+package scs
+
+import "testing"
+
+// TestStrIsScreamingSnake tests StrIsScreamingSnake function.
+func TestStrIsScreamingSnake(t *testing.T) {
+	tests := []struct {
+		value  string
+		result bool
+	}{
+		// Simple examples
+		{"ONE", true},
+		{"one", false},
+		{"ONE_TWO_THREE", true},
+		{"One_Two_Three", false},
+		{"ICE9", true},
+		{"ICE_9", true},
+
+		// Examples with abbreviations
+		{"IS_WWW_CONNECTION", true},
+		{"HTTPToHTTPS", false},
+		{"HTTP-TO-HTTPS", false},
+	}
+
+	for _, s := range tests {
+		if r := StrIsScreamingSnake(s.value); s.result != r {
+			t.Errorf("test for `%s` is failed, "+
+				"expected %t but %t", s.value, s.result, r)
+		}
+	}
+}
+
+// TestStrToScreamingSnake tests StrToScreamingSnake function.
+func TestStrToScreamingSnake(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		// Simple examples
+		{"One", "ONE"},
+		{" One two Three ", "ONE_TWO_THREE"},
+		{"Ice 9", "ICE_9"},
+
+		// Examples with abbreviations
+		{"is www Connection", "IS_WWW_CONNECTION"},
+		{"http to https", "HTTP_TO_HTTPS"},
+	}
+
+	for i, s := range tests {
+		if r := StrToScreamingSnake(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestToScreamingSnake tests ToScreamingSnake function.
+func TestToScreamingSnake(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		// Simple examples
+		{"One", "ONE"},
+		{" One two Three ", "ONE_TWO_THREE"},
+		{"Ice 9", "ICE_9"},
+
+		// Examples with abbreviations
+		{"isWWWConnection", "IS_WWW_CONNECTION"},
+		{"HTTPToHTTPS", "HTTP_TO_HTTPS"},
+		{"is-http-or-https", "IS_HTTP_OR_HTTPS"},
+		{"is_http_or_https", "IS_HTTP_OR_HTTPS"},
+		{"IS_HTTP_OR_HTTPS", "IS_HTTP_OR_HTTPS"},
+	}
+
+	for i, s := range tests {
+		if r := ToScreamingSnake(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestScreamingSnakeTo tests ScreamingSnakeTo* functions.
+func TestScreamingSnakeTo(t *testing.T) {
+	tests := []struct {
+		fn     func(string) (string, error)
+		value  string
+		result string
+	}{
+		{ScreamingSnakeToCamel, "IS_HTTP_OR_HTTPS", "isHTTPOrHTTPS"},
+		{ScreamingSnakeToKebab, "IS_HTTP_OR_HTTPS", "is-http-or-https"},
+		{ScreamingSnakeToPascal, "IS_HTTP_OR_HTTPS", "IsHTTPOrHTTPS"},
+		{ScreamingSnakeToSnake, "IS_HTTP_OR_HTTPS", "is_http_or_https"},
+		{ScreamingSnakeToCamel, "ICE_9", "ice9"},
+		{ScreamingSnakeToKebab, "ICE_9", "ice-9"},
+		{ScreamingSnakeToPascal, "ICE_9", "Ice9"},
+		{ScreamingSnakeToSnake, "ICE_9", "ice_9"},
+	}
+
+	for i, s := range tests {
+		r, err := s.fn(s.value)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r != s.result {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestScreamingSnakeToError tests ScreamingSnakeTo* functions
+// with wrong value.
+func TestScreamingSnakeToError(t *testing.T) {
+	notScreamingSnake := "one_two_three"
+
+	for _, fn := range []func(string) (string, error){
+		ScreamingSnakeToCamel,
+		ScreamingSnakeToKebab,
+		ScreamingSnakeToPascal,
+		ScreamingSnakeToSnake,
+	} {
+		if _, err := fn(notScreamingSnake); err == nil {
+			t.Error("not screaming snake converted")
+		}
+	}
+}
+
+// TestToScreamingSnakeFrom tests *ToScreamingSnake functions.
+func TestToScreamingSnakeFrom(t *testing.T) {
+	tests := []struct {
+		fn     func(string) (string, error)
+		value  string
+		result string
+	}{
+		{CamelToScreamingSnake, "isHTTPOrHTTPS", "IS_HTTP_OR_HTTPS"},
+		{KebabToScreamingSnake, "is-http-or-https", "IS_HTTP_OR_HTTPS"},
+		{PascalToScreamingSnake, "IsHTTPOrHTTPS", "IS_HTTP_OR_HTTPS"},
+		{SnakeToScreamingSnake, "is_http_or_https", "IS_HTTP_OR_HTTPS"},
+	}
+
+	for i, s := range tests {
+		r, err := s.fn(s.value)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r != s.result {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+
+	if _, err := SnakeToScreamingSnake("oneTwo"); err == nil {
+		t.Error("not snake to screaming snake")
+	}
+}
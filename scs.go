@@ -1,10 +1,5 @@
 package scs
 
-import (
-	"fmt"
-	"strings"
-)
-
 const (
 	// Camel is constant that characterizes string case style as camelCase.
 	Camel CaseStyle = 1 << iota
@@ -17,18 +12,54 @@ const (
 
 	// Snake is constant that characterizes string case style as snake_case.
 	Snake
+
+	// ScreamingSnake is constant that characterizes string case style
+	// as SCREAMING_SNAKE_CASE.
+	ScreamingSnake
+
+	// Train is constant that characterizes string case style as Train-Case
+	// (also known as HTTP-Header-Case).
+	Train
+
+	// Dot is constant that characterizes string case style as dot.case.
+	Dot
+
+	// Path is constant that characterizes string case style as path/case.
+	Path
+
+	// Backslash is constant that characterizes string case style
+	// as Backslash\Case (namespace style).
+	Backslash
 )
 
 // CaseStyle is string case style type.
-type CaseStyle uint8
+//
+// Each style occupies one bit, so several styles can be combined
+// into a single value, as the DetectAll function does.
+type CaseStyle uint32
+
+// Has returns true if the CaseStyle contains the given style.
+// If the style is a combination of styles, all of them must be
+// contained.
+//
+// Example usage:
+//
+//	styles := scs.DetectAll("hello")
+//	styles.Has(scs.Snake)           // true
+//	styles.Has(scs.Snake|scs.Kebab) // true
+//	styles.Has(scs.Pascal)          // false
+func (c CaseStyle) Has(style CaseStyle) bool {
+	return style != 0 && c&style == style
+}
 
 // StringCaseStyle is object of the string case style (SCS).
 // It can be created correctly through the New function only.
 type StringCaseStyle struct {
-	do      func(string) string // convert raw string to the case-styling value
-	style   CaseStyle           // is the flag of the string case style
-	value   string              // value in case-style format
-	isValid bool                // true if the object was created correctly
+	do        func(string) string // convert raw string to the case-styling value
+	converter *Converter          // converter with the rules of the object
+	style     CaseStyle           // is the flag of the string case style
+	value     string              // value in case-style format
+	isValid   bool                // true if the object was created correctly
 }
 
 // New returns a pointer to a string case style object. The style defines
@@ -36,11 +67,13 @@ type StringCaseStyle struct {
 //
 // This function creates a new instance of the StringCaseStyle struct, which
 // represents a specific string case style. It takes a CaseStyle parameter
-// to determine the desired case style (Camel, Kebab, Pascal, Snake), and
+// to determine the desired case style (Camel, Kebab, Pascal, Snake,
+// ScreamingSnake, Train, Dot, Path, Backslash), and
 // one or more string values to be formatted.
 //
 // The function initializes the `do` field of the StringCaseStyle struct with
-// the appropriate formatting function based on the specified case style.
+// the formatting function of the specified case style, which can be any
+// of the built-in styles or a style added by the Register function.
 // It then joins the input strings with a space separator and applies the
 // formatting function to the resulting string. The formatted string is stored
 // in the `value` field of the StringCaseStyle struct.
@@ -48,32 +81,14 @@ type StringCaseStyle struct {
 // If an incorrect case style is provided, the function returns an error along
 // with a nil pointer to the StringCaseStyle.
 //
+// The object uses the rules of the default converter. Use the New method
+// of a Converter to create an object with other rules.
+//
 // Example usage:
 //
 //	style, err := scs.New(scs.Camel, "hello", "world")
 func New(style CaseStyle, value ...string) (*StringCaseStyle, error) {
-	var do func(string) string
-
-	switch style {
-	case Camel:
-		do = StrToCamel
-	case Kebab:
-		do = StrToKebab
-	case Pascal:
-		do = StrToPascal
-	case Snake:
-		do = StrToSnake
-	default:
-		return &StringCaseStyle{do: func(s string) string { return s }},
-			fmt.Errorf("incorrect case style")
-	}
-
-	return &StringCaseStyle{
-		do:      do,
-		style:   style,
-		value:   do(strings.Join(value, " ")),
-		isValid: true,
-	}, nil
+	return defaultConverter.New(style, value...)
 }
 
 // IsValid returns true if the StringCaseStyle object is valid.
@@ -175,6 +190,74 @@ func (o *StringCaseStyle) IsSnake() bool {
 	return o.style == Snake
 }
 
+// IsScreamingSnake returns true if the StringCaseStyle object represents
+// a SCREAMING_SNAKE_CASE value.
+//
+// Example usage:
+//
+//	style, _ := New(ScreamingSnake, "hello world")
+//	isScreamingSnake := style.IsScreamingSnake()
+//	// isScreamingSnake: true
+//
+//	style, _ = New(Snake, "hello world")
+//	isScreamingSnake = style.IsScreamingSnake()
+//	// isScreamingSnake: false
+func (o *StringCaseStyle) IsScreamingSnake() bool {
+	return o.style == ScreamingSnake
+}
+
+// IsTrain returns true if the StringCaseStyle object represents
+// a Train-Case value.
+//
+// Example usage:
+//
+//	style, _ := New(Train, "content type")
+//	isTrain := style.IsTrain()
+//	// isTrain: true
+//
+//	style, _ = New(Kebab, "content type")
+//	isTrain = style.IsTrain()
+//	// isTrain: false
+func (o *StringCaseStyle) IsTrain() bool {
+	return o.style == Train
+}
+
+// IsDot returns true if the StringCaseStyle object represents
+// a dot.case value.
+//
+// Example usage:
+//
+//	style, _ := New(Dot, "max open conns")
+//	isDot := style.IsDot()
+//	// isDot: true
+func (o *StringCaseStyle) IsDot() bool {
+	return o.style == Dot
+}
+
+// IsPath returns true if the StringCaseStyle object represents
+// a path/case value.
+//
+// Example usage:
+//
+//	style, _ := New(Path, "users profile")
+//	isPath := style.IsPath()
+//	// isPath: true
+func (o *StringCaseStyle) IsPath() bool {
+	return o.style == Path
+}
+
+// IsBackslash returns true if the StringCaseStyle object represents
+// a Backslash\Case value.
+//
+// Example usage:
+//
+//	style, _ := New(Backslash, "app http client")
+//	isBackslash := style.IsBackslash()
+//	// isBackslash: true
+func (o *StringCaseStyle) IsBackslash() bool {
+	return o.style == Backslash
+}
+
 // Eat converts a string to the specified style and stores it
 // as the object value.
 //
@@ -235,188 +318,233 @@ func (o *StringCaseStyle) Value() string {
 	return o.value
 }
 
-// CopyToCamel converts an object to Camel Type StringCaseStyle
-// and returns new pointer to it.
-func (o *StringCaseStyle) CopyToCamel() (*StringCaseStyle, error) {
-	var (
-		value string
-		err   error
-	)
-
-	switch o.style {
-	case Camel:
-		value = o.value
-	case Kebab:
-		value, err = KebabToCamel(o.value)
-	case Pascal:
-		value, err = PascalToCamel(o.value)
-	case Snake:
-		value, err = SnakeToCamel(o.value)
-	}
+// Name returns the value of the object parsed into words with the rules
+// of the object. The name can be rendered in any style without parsing
+// the value again.
+//
+// Example usage:
+//
+//	style, _ := New(Snake, "https server")
+//	name := style.Name()
+//	name.Pascal() // HTTPSServer
+//	name.Kebab()  // https-server
+func (o *StringCaseStyle) Name() Name {
+	return o.rules().ParseName(o.value)
+}
 
-	return &StringCaseStyle{
-		do:      StrToCamel,
-		style:   Camel,
-		value:   value,
-		isValid: err == nil,
-	}, err
+// SetProfile sets the profile of the abbreviations dictionary used by
+// the StringCaseStyle object and re-renders its value with the new
+// dictionary. The updated object is returned for method chaining.
+//
+// Example usage:
+//
+//	style, _ := New(Pascal, "cat food")
+//	// style.Value(): "CATFood"
+//
+//	style.SetProfile(ProfileGoLint)
+//	// style.Value(): "CatFood"
+func (o *StringCaseStyle) SetProfile(p Profile) *StringCaseStyle {
+	c := o.rules().clone()
+	c.abbreviations = newDictionary(p.abbreviations())
+	return o.apply(c)
 }
 
-// ToCamel converts an object to Camel Type StringCaseStyle.
-func (o *StringCaseStyle) ToCamel() error {
-	obj, err := o.CopyToCamel()
-	o.style = obj.style
-	o.value = obj.value
-	o.do = obj.do
-	o.isValid = obj.isValid
+// SetAcronymPolicy sets the policy that defines how abbreviations are
+// written in the value of the StringCaseStyle object and re-renders its
+// value with the new policy. The updated object is returned for method
+// chaining.
+//
+// Example usage:
+//
+//	style, _ := New(Pascal, "http server")
+//	// style.Value(): "HTTPServer"
+//
+//	style.SetAcronymPolicy(TitleOnly)
+//	// style.Value(): "HttpServer"
+func (o *StringCaseStyle) SetAcronymPolicy(p AcronymPolicy) *StringCaseStyle {
+	c := o.rules().clone()
+	c.acronyms = p
+	return o.apply(c)
+}
 
-	return err
+// SetNumberPolicy sets the policy that defines how numbers inside words
+// are split and re-renders the value of the StringCaseStyle object with
+// the new policy. The updated object is returned for method chaining.
+//
+// Example usage:
+//
+//	style, _ := New(Snake, "utf8 decoder")
+//	// style.Value(): "utf_8_decoder"
+//
+//	style.SetNumberPolicy(AttachToPrevious).Set("utf8 decoder")
+//	// style.Value(): "utf8_decoder"
+func (o *StringCaseStyle) SetNumberPolicy(p NumberPolicy) *StringCaseStyle {
+	c := o.rules().clone()
+	c.numbers = p
+	return o.apply(c)
 }
 
-// CopyToKebab converts an object to Kebab Type StringCaseStyle
-// and returns new pointer to it.
-func (o *StringCaseStyle) CopyToKebab() (*StringCaseStyle, error) {
-	var (
-		value string
-		err   error
-	)
-
-	switch o.style {
-	case Camel:
-		value, err = CamelToKebab(o.value)
-	case Kebab:
-		value = o.value
-	case Pascal:
-		value, err = PascalToKebab(o.value)
-	case Snake:
-		value, err = SnakeToKebab(o.value)
+// The apply sets the converter with the rules of the object
+// and re-renders its value.
+func (o *StringCaseStyle) apply(c *Converter) *StringCaseStyle {
+	if e, ok := lookup(o.style); ok && o.isValid {
+		o.do = c.formatter(e)
+		o.value = o.do(o.value)
 	}
 
-	return &StringCaseStyle{
-		do:      StrToKebab,
-		style:   Kebab,
-		value:   value,
-		isValid: err == nil,
-	}, err
+	o.converter = c
+	return o
 }
 
-// ToKebab converts an object to Kebab Type StringCaseStyle.
-func (o *StringCaseStyle) ToKebab() error {
-	if o.style == Kebab {
-		return nil
+// The rules returns the converter with the rules of the object.
+func (o *StringCaseStyle) rules() *Converter {
+	if o.converter == nil {
+		return defaultConverter
 	}
 
-	var newValue string
-	var err error
+	return o.converter
+}
 
-	switch o.style {
-	case Camel:
-		newValue, err = CamelToKebab(o.value)
-	case Pascal:
-		newValue, err = PascalToKebab(o.value)
-	case Snake:
-		newValue, err = SnakeToKebab(o.value)
+// CopyTo converts an object to the given style and returns new pointer
+// to it. The style can be any of the built-in styles or a style added
+// by the Register function.
+//
+// Example usage:
+//
+//	style, _ := New(Snake, "hello world")
+//	kebab, err := style.CopyTo(Kebab)
+//	// kebab.Value(): "hello-world", err: nil
+func (o *StringCaseStyle) CopyTo(style CaseStyle) (*StringCaseStyle, error) {
+	c := o.rules()
+	obj, err := c.New(style)
+	if err != nil {
+		return obj, err
 	}
 
+	if o.style == style {
+		obj.value = o.value
+	} else {
+		obj.value, err = c.convert(o.value, o.style, style)
+	}
+
+	obj.isValid = err == nil
+	return obj, err
+}
+
+// To converts an object to the given style. The object remains
+// unchanged if the conversion fails.
+//
+// Example usage:
+//
+//	style, _ := New(Snake, "hello world")
+//	err := style.To(Kebab)
+//	// style.Value(): "hello-world", err: nil
+func (o *StringCaseStyle) To(style CaseStyle) error {
+	obj, err := o.CopyTo(style)
 	if err != nil {
 		return err
 	}
 
-	o.value = newValue
-	o.style = Kebab
-	o.do = StrToKebab
+	*o = *obj
 	return nil
 }
 
+// CopyToCamel converts an object to Camel Type StringCaseStyle
+// and returns new pointer to it.
+func (o *StringCaseStyle) CopyToCamel() (*StringCaseStyle, error) {
+	return o.CopyTo(Camel)
+}
+
+// ToCamel converts an object to Camel Type StringCaseStyle.
+func (o *StringCaseStyle) ToCamel() error {
+	return o.To(Camel)
+}
+
+// CopyToKebab converts an object to Kebab Type StringCaseStyle
+// and returns new pointer to it.
+func (o *StringCaseStyle) CopyToKebab() (*StringCaseStyle, error) {
+	return o.CopyTo(Kebab)
+}
+
+// ToKebab converts an object to Kebab Type StringCaseStyle.
+func (o *StringCaseStyle) ToKebab() error {
+	return o.To(Kebab)
+}
+
 // CopyToPascal converts an object to Pascal Type StringCaseStyle
 // and returns new pointer to it.
 func (o *StringCaseStyle) CopyToPascal() (*StringCaseStyle, error) {
-	var (
-		value string
-		err   error
-	)
-
-	switch o.style {
-	case Camel:
-		value, err = CamelToPascal(o.value)
-	case Kebab:
-		value, err = KebabToPascal(o.value)
-	case Pascal:
-		value = o.value
-	case Snake:
-		value, err = SnakeToPascal(o.value)
-	}
-
-	return &StringCaseStyle{
-		do:      StrToPascal,
-		style:   Pascal,
-		value:   value,
-		isValid: err == nil,
-	}, err
+	return o.CopyTo(Pascal)
 }
 
 // ToPascal converts an object to Pascal Type StringCaseStyle.
 func (o *StringCaseStyle) ToPascal() error {
-	if o.style == Pascal {
-		return nil
-	}
+	return o.To(Pascal)
+}
 
-	var newValue string
-	var err error
+// CopyToSnake converts an object to Snake Type StringCaseStyle
+// and returns new pointer to it.
+func (o *StringCaseStyle) CopyToSnake() (*StringCaseStyle, error) {
+	return o.CopyTo(Snake)
+}
 
-	switch o.style {
-	case Camel:
-		newValue, err = CamelToPascal(o.value)
-	case Kebab:
-		newValue, err = KebabToPascal(o.value)
-	case Snake:
-		newValue, err = SnakeToPascal(o.value)
-	}
+// ToSnake converts an object to Snake Type StringCaseStyle.
+func (o *StringCaseStyle) ToSnake() error {
+	return o.To(Snake)
+}
 
-	if err != nil {
-		return err
-	}
+// CopyToScreamingSnake converts an object to ScreamingSnake Type
+// StringCaseStyle and returns new pointer to it.
+func (o *StringCaseStyle) CopyToScreamingSnake() (*StringCaseStyle, error) {
+	return o.CopyTo(ScreamingSnake)
+}
 
-	o.value = newValue
-	o.style = Pascal
-	o.do = StrToPascal
-	return nil
+// ToScreamingSnake converts an object to ScreamingSnake Type
+// StringCaseStyle.
+func (o *StringCaseStyle) ToScreamingSnake() error {
+	return o.To(ScreamingSnake)
 }
 
-// CopyToSnake converts an object to Snake Type StringCaseStyle
+// CopyToTrain converts an object to Train Type StringCaseStyle
 // and returns new pointer to it.
-func (o *StringCaseStyle) CopyToSnake() (*StringCaseStyle, error) {
-	var (
-		value string
-		err   error
-	)
-
-	switch o.style {
-	case Camel:
-		value, err = CamelToSnake(o.value)
-	case Kebab:
-		value, err = KebabToSnake(o.value)
-	case Pascal:
-		value, err = PascalToSnake(o.value)
-	case Snake:
-		value = o.value
-	}
+func (o *StringCaseStyle) CopyToTrain() (*StringCaseStyle, error) {
+	return o.CopyTo(Train)
+}
 
-	return &StringCaseStyle{
-		do:      StrToSnake,
-		style:   Snake,
-		value:   value,
-		isValid: err == nil,
-	}, err
+// ToTrain converts an object to Train Type StringCaseStyle.
+func (o *StringCaseStyle) ToTrain() error {
+	return o.To(Train)
 }
 
-// ToSnake converts an object to Snake Type StringCaseStyle.
-func (o *StringCaseStyle) ToSnake() error {
-	obj, err := o.CopyToSnake()
-	o.style = obj.style
-	o.value = obj.value
-	o.do = obj.do
-	o.isValid = obj.isValid
+// CopyToDot converts an object to Dot Type StringCaseStyle
+// and returns new pointer to it.
+func (o *StringCaseStyle) CopyToDot() (*StringCaseStyle, error) {
+	return o.CopyTo(Dot)
+}
+
+// ToDot converts an object to Dot Type StringCaseStyle.
+func (o *StringCaseStyle) ToDot() error {
+	return o.To(Dot)
+}
+
+// CopyToPath converts an object to Path Type StringCaseStyle
+// and returns new pointer to it.
+func (o *StringCaseStyle) CopyToPath() (*StringCaseStyle, error) {
+	return o.CopyTo(Path)
+}
+
+// ToPath converts an object to Path Type StringCaseStyle.
+func (o *StringCaseStyle) ToPath() error {
+	return o.To(Path)
+}
+
+// CopyToBackslash converts an object to Backslash Type StringCaseStyle
+// and returns new pointer to it.
+func (o *StringCaseStyle) CopyToBackslash() (*StringCaseStyle, error) {
+	return o.CopyTo(Backslash)
+}
 
-	return err
+// ToBackslash converts an object to Backslash Type StringCaseStyle.
+func (o *StringCaseStyle) ToBackslash() error {
+	return o.To(Backslash)
 }
@@ -312,3 +312,182 @@ func TestObjSet(t *testing.T) {
 		t.Error("conversion failed")
 	}
 }
+
+// TestObjScreamingSnakeCopyTo tests ScreamingSnake -> CopyTo* method
+// of the object.
+func TestObjScreamingSnakeCopyTo(t *testing.T) {
+	basic, _ := New(ScreamingSnake, "http 2 https convertor")
+	tests := []struct {
+		fn     func() (*StringCaseStyle, error)
+		result string
+	}{
+		{basic.CopyToCamel, "http2HTTPSConvertor"},
+		{basic.CopyToKebab, "http-2-https-convertor"},
+		{basic.CopyToPascal, "HTTP2HTTPSConvertor"},
+		{basic.CopyToSnake, "http_2_https_convertor"},
+		{basic.CopyToScreamingSnake, "HTTP_2_HTTPS_CONVERTOR"},
+	}
+
+	for _, test := range tests {
+		obj, err := test.fn()
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r := obj.Value(); r != test.result {
+			t.Errorf("expected %s but %s", test.result, r)
+		}
+	}
+}
+
+// TestObjToScreamingSnake tests * -> ToScreamingSnake method of the object.
+func TestObjToScreamingSnake(t *testing.T) {
+	expected := "HTTP_2_HTTPS_CONVERTOR"
+
+	for _, style := range []CaseStyle{Camel, Kebab, Pascal, Snake} {
+		obj, _ := New(style, "http 2 https convertor")
+		if err := obj.ToScreamingSnake(); err != nil {
+			t.Error(err)
+		}
+
+		if !obj.IsScreamingSnake() {
+			t.Error("test for IsScreamingSnake() is failed, " +
+				"expected true but false")
+		}
+
+		if r := obj.Value(); r != expected {
+			t.Errorf("expected %s but %s", expected, r)
+		}
+	}
+}
+
+// TestObjTrainCopyTo tests Train -> CopyTo* method of the object.
+func TestObjTrainCopyTo(t *testing.T) {
+	basic, _ := New(Train, "http 2 https convertor")
+	tests := []struct {
+		fn     func() (*StringCaseStyle, error)
+		result string
+	}{
+		{basic.CopyToCamel, "http2HTTPSConvertor"},
+		{basic.CopyToKebab, "http-2-https-convertor"},
+		{basic.CopyToPascal, "HTTP2HTTPSConvertor"},
+		{basic.CopyToSnake, "http_2_https_convertor"},
+		{basic.CopyToScreamingSnake, "HTTP_2_HTTPS_CONVERTOR"},
+		{basic.CopyToTrain, "HTTP-2-HTTPS-Convertor"},
+	}
+
+	for _, test := range tests {
+		obj, err := test.fn()
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r := obj.Value(); r != test.result {
+			t.Errorf("expected %s but %s", test.result, r)
+		}
+	}
+}
+
+// TestObjToTrain tests * -> ToTrain method of the object.
+func TestObjToTrain(t *testing.T) {
+	expected := "HTTP-2-HTTPS-Convertor"
+
+	for _, style := range []CaseStyle{Camel, Kebab, Pascal, Snake,
+		ScreamingSnake} {
+		obj, _ := New(style, "http 2 https convertor")
+		if err := obj.ToTrain(); err != nil {
+			t.Error(err)
+		}
+
+		if !obj.IsTrain() {
+			t.Error("test for IsTrain() is failed, expected true but false")
+		}
+
+		if r := obj.Value(); r != expected {
+			t.Errorf("expected %s but %s", expected, r)
+		}
+	}
+}
+
+// TestObjSeparatedCopyTo tests Dot, Path and Backslash -> CopyTo* methods
+// of the object.
+func TestObjSeparatedCopyTo(t *testing.T) {
+	results := map[CaseStyle]string{
+		Camel:          "http2HTTPSConvertor",
+		Kebab:          "http-2-https-convertor",
+		Pascal:         "HTTP2HTTPSConvertor",
+		Snake:          "http_2_https_convertor",
+		ScreamingSnake: "HTTP_2_HTTPS_CONVERTOR",
+		Train:          "HTTP-2-HTTPS-Convertor",
+		Dot:            "http.2.https.convertor",
+		Path:           "http/2/https/convertor",
+		Backslash:      `Http\2\Https\Convertor`,
+	}
+
+	for from := range results {
+		basic, err := New(from, "http 2 https convertor")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r := basic.Value(); r != results[from] {
+			t.Errorf("expected %s but %s", results[from], r)
+		}
+
+		for _, fn := range []func() (*StringCaseStyle, error){
+			basic.CopyToDot,
+			basic.CopyToPath,
+			basic.CopyToBackslash,
+		} {
+			obj, err := fn()
+			if err != nil {
+				t.Error(err)
+			}
+
+			if r, e := obj.Value(), results[obj.style]; r != e {
+				t.Errorf("expected %s but %s", e, r)
+			}
+		}
+	}
+}
+
+// TestObjToSeparated tests Dot, Path and Backslash -> To* methods
+// of the object.
+func TestObjToSeparated(t *testing.T) {
+	for _, style := range []CaseStyle{Dot, Path, Backslash} {
+		obj, _ := New(style, "http 2 https convertor")
+		if err := obj.ToScreamingSnake(); err != nil {
+			t.Error(err)
+		}
+
+		if r := obj.Value(); r != "HTTP_2_HTTPS_CONVERTOR" {
+			t.Errorf("expected HTTP_2_HTTPS_CONVERTOR but %s", r)
+		}
+
+		obj, _ = New(style, "http 2 https convertor")
+		if err := obj.ToTrain(); err != nil {
+			t.Error(err)
+		}
+
+		if r := obj.Value(); r != "HTTP-2-HTTPS-Convertor" {
+			t.Errorf("expected HTTP-2-HTTPS-Convertor but %s", r)
+		}
+	}
+
+	obj, _ := New(Dot, "max open conns")
+	if err := obj.ToPath(); err != nil || !obj.IsPath() {
+		t.Error("test for ToPath() is failed")
+	}
+
+	if err := obj.ToBackslash(); err != nil || !obj.IsBackslash() {
+		t.Error("test for ToBackslash() is failed")
+	}
+
+	if err := obj.ToDot(); err != nil || !obj.IsDot() {
+		t.Error("test for ToDot() is failed")
+	}
+
+	if r := obj.Value(); r != "max.open.conns" {
+		t.Errorf("expected max.open.conns but %s", r)
+	}
+}
@@ -1,15 +1,8 @@
 package scs
 
-import (
-	"fmt"
-	"regexp"
-	"strings"
-)
+import "regexp"
 
-var (
-	snakeBody   = regexp.MustCompile(`_([a-zA-Z0-9])`)
-	isSnakeCase = regexp.MustCompile("(^[a-z0-9_]+_[a-z0-9_]+$)|(^[a-z0-9]+$)")
-)
+var isSnakeCase = regexp.MustCompile("(^[a-z0-9_]+_[a-z0-9_]+$)|(^[a-z0-9]+$)")
 
 // StrIsSnake returns true if the string is in snake_case.
 //
@@ -27,12 +20,10 @@ func StrIsSnake(s string) bool {
 
 // StrToSnake converts a string to snake_case.
 //
-// This function first transforms the input string by replacing all
-// non-alphanumeric characters with spaces.
-//
-// It then splits the string into chunks at the spaces, converts
-// each chunk to lower case, and finally joins the chunks back together
-// with underscores.
+// This function splits the input string into words at non-alphanumeric
+// characters, at capital letters and at digits, converts each word to
+// lower case, and finally joins the words back together with underscores.
+// Runs of capitals are split at the abbreviations they contain.
 //
 // Note that this function will convert upper case letters to lower case,
 // so the output string will be all lower case even if the input string
@@ -40,11 +31,13 @@ func StrIsSnake(s string) bool {
 //
 // Example usage:
 //
-//	scs.StrToSnake("Hello World") // returns "hello_world"
-//	scs.StrToSnake("hello world") // returns "hello_world"
-//	scs.StrToSnake("hello-world") // returns "hello_world"
+//	scs.StrToSnake("Hello World")    // returns "hello_world"
+//	scs.StrToSnake("hello-world")    // returns "hello_world"
+//	scs.StrToSnake("HTTPServer")     // returns "http_server"
+//	scs.StrToSnake("XMLHttpRequest") // returns "xml_http_request"
+//	scs.StrToSnake("IDs")            // returns "ids"
 func StrToSnake(s string) string {
-	return toSeparate(s, "_")
+	return defaultConverter.StrToSnake(s)
 }
 
 // ToSnake converts a string to snake_case.
@@ -68,21 +61,7 @@ func StrToSnake(s string) string {
 //	scs.ToSnake("hello_world")  // returns "hello_world"
 //	scs.ToSnake("Hello World")  // returns "hello_world"
 func ToSnake(s string) string {
-	switch {
-	case StrIsCamel(s):
-		r, _ := CamelToSnake(s)
-		return r
-	case StrIsKebab(s):
-		r, _ := KebabToSnake(s)
-		return r
-	case StrIsPascal(s):
-		r, _ := PascalToSnake(s)
-		return r
-	case StrIsSnake(s):
-		return s
-	}
-
-	return StrToSnake(s)
+	return defaultConverter.ToSnake(s)
 }
 
 // SnakeToCamel converts a snake_case-style string to camelCase.
@@ -90,8 +69,9 @@ func ToSnake(s string) string {
 // This function checks if the input string is in snake_case.
 // If not, it returns an error.
 //
-// If the input string is in snake_case, it replaces all underscores
-// with spaces and converts the resulting string to camelCase.
+// If the input string is in snake_case, it is split into words at
+// underscores and numbers, and the words are joined without delimiters,
+// with abbreviations spelled as in the dictionary ("user_id" -> "userID").
 //
 // Note that the first word in the output string will be in lower case,
 // and the first letter of each subsequent word will be in upper case.
@@ -106,18 +86,7 @@ func ToSnake(s string) string {
 //	scs.SnakeToCamel("HelloWorld")  // returns "", error
 //	scs.SnakeToCamel("hello-world") // returns "", error
 func SnakeToCamel(snake string) (string, error) {
-	if !StrIsSnake(snake) {
-		return "", fmt.Errorf("value %s isn't snake_case style", snake)
-	}
-
-	result := StrToCamel(
-		snakeBody.ReplaceAllStringFunc(
-			snake,
-			func(s string) string { return strings.Replace(s, "_", " ", -1) },
-		),
-	)
-
-	return result, nil
+	return defaultConverter.convert(snake, Snake, Camel)
 }
 
 // SnakeToKebab converts a snake_case-style string to kebab-case.
@@ -125,8 +94,8 @@ func SnakeToCamel(snake string) (string, error) {
 // This function checks if the input string is in snake_case. If it's not,
 // it returns an error.
 //
-// If the input string is in snake_case, it replaces all underscores with
-// hyphens, effectively converting the string from snake_case to kebab-case.
+// If the input string is in snake_case, it is split into words by the
+// tokenizer and the words are joined with hyphens in lower case.
 //
 // Note that this conversion could fail if the input string is not in
 // snake_case style. In that case, an error will be returned along with
@@ -138,11 +107,7 @@ func SnakeToCamel(snake string) (string, error) {
 //	scs.SnakeToKebab("HelloWorld")  // returns "", error
 //	scs.SnakeToKebab("helloWorld")  // returns "", error
 func SnakeToKebab(snake string) (string, error) {
-	if !StrIsSnake(snake) {
-		return "", fmt.Errorf("value %s isn't snake_case style", snake)
-	}
-
-	return strings.ReplaceAll(snake, "_", "-"), nil
+	return defaultConverter.convert(snake, Snake, Kebab)
 }
 
 // SnakeToPascal converts a snake_case-style string to PascalCase.
@@ -150,10 +115,9 @@ func SnakeToKebab(snake string) (string, error) {
 // This function checks if the input string is in snake_case. If it's not,
 // it returns an error.
 //
-// If the input string is in snake_case, it replaces all underscores with
-// spaces and converts the resulting string to PascalCase. The PascalCase
-// format starts with an uppercase letter, and includes an uppercase letter
-// at the beginning of each new word.
+// If the input string is in snake_case, it is split into words by the
+// tokenizer, and the words are capitalized and joined without delimiters.
+// Abbreviations are spelled as in the dictionary ("api_key" -> "APIKey").
 //
 // Note that this conversion could fail if the input string is not in
 // snake_case style. In that case, an error will be returned along with
@@ -165,16 +129,71 @@ func SnakeToKebab(snake string) (string, error) {
 //	scs.SnakeToPascal("HelloWorld")  // returns "", error
 //	scs.SnakeToPascal("hello-world") // returns "", error
 func SnakeToPascal(snake string) (string, error) {
-	if !StrIsSnake(snake) {
-		return "", fmt.Errorf("value %s isn't snake_case style", snake)
-	}
+	return defaultConverter.convert(snake, Snake, Pascal)
+}
+
+// SnakeToScreamingSnake converts a snake_case-style string
+// to SCREAMING_SNAKE_CASE.
+//
+// This function checks if the input string is in snake_case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	scs.SnakeToScreamingSnake("hello_world") // returns "HELLO_WORLD", nil
+//	scs.SnakeToScreamingSnake("HelloWorld")  // returns "", error
+func SnakeToScreamingSnake(snake string) (string, error) {
+	return defaultConverter.convert(snake, Snake, ScreamingSnake)
+}
 
-	result := StrToPascal(
-		snakeBody.ReplaceAllStringFunc(
-			snake,
-			func(s string) string { return strings.Replace(s, "_", " ", -1) },
-		),
-	)
+// SnakeToTrain converts a snake_case-style string to Train-Case.
+//
+// This function checks if the input string is in snake_case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := SnakeToTrain("x_request_id")
+//	// result: "X-Request-ID", err: nil
+func SnakeToTrain(snake string) (string, error) {
+	return defaultConverter.convert(snake, Snake, Train)
+}
 
-	return result, nil
+// SnakeToDot converts a snake_case-style string to dot.case.
+//
+// This function checks if the input string is in snake_case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := SnakeToDot("hello_world")
+//	// result: "hello.world", err: nil
+func SnakeToDot(snake string) (string, error) {
+	return defaultConverter.convert(snake, Snake, Dot)
+}
+
+// SnakeToPath converts a snake_case-style string to path/case.
+//
+// This function checks if the input string is in snake_case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := SnakeToPath("hello_world")
+//	// result: "hello/world", err: nil
+func SnakeToPath(snake string) (string, error) {
+	return defaultConverter.convert(snake, Snake, Path)
+}
+
+// SnakeToBackslash converts a snake_case-style string to Backslash\Case.
+//
+// This function checks if the input string is in snake_case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := SnakeToBackslash("hello_world")
+//	// result: `Hello\World`, err: nil
+func SnakeToBackslash(snake string) (string, error) {
+	return defaultConverter.convert(snake, Snake, Backslash)
 }
@@ -0,0 +1,138 @@
+package scs
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Style is the interface that describes a string case style.
+//
+// Split splits a value written in the style into words in lower case.
+//
+// Join joins words into a value written in the style. The words are
+// passed in their natural spelling: abbreviations are written as in the
+// abbreviations dictionary (e.g., "HTTP") and all other words are written
+// in lower case.
+//
+// Is returns true if the value is written in the style.
+//
+// Any Style registered with the Register function can be used with New
+// and converted to and from any other registered style.
+type Style interface {
+	Split(s string) []string
+	Join(words []string) string
+	Is(s string) bool
+}
+
+// The converterSplitter is implemented by the built-in styles, which
+// split values with the tokenizer of the converter.
+type converterSplitter interface {
+	splitWith(s string, c *Converter) []string
+}
+
+// The segmentKeeper is implemented by the styles that keep a value
+// already written in the style instead of splitting it into words,
+// such as the path/case with kebab words in segments.
+type segmentKeeper interface {
+	keeps(s string) bool
+}
+
+// The unitedStyle is a style in which words are joined together without
+// a delimiter and each word starts with a capital letter, such as
+// PascalCase. If firstWordIsLower is true, the first word is written
+// in lower case, such as camelCase.
+type unitedStyle struct {
+	re               *regexp.Regexp
+	firstWordIsLower bool
+}
+
+// Split splits a value into words at capital letters and digits.
+func (u unitedStyle) Split(s string) []string {
+	return lowerAll(u.splitWith(s, defaultConverter))
+}
+
+// The splitWith splits a value into words as written in the value
+// with the tokenizer of the converter.
+func (u unitedStyle) splitWith(s string, c *Converter) []string {
+	return c.Words(s)
+}
+
+// Join joins words without a delimiter.
+func (u unitedStyle) Join(words []string) string {
+	return joinUnited(words, u.firstWordIsLower)
+}
+
+// Is returns true if the value is written in the style.
+func (u unitedStyle) Is(s string) bool {
+	return u.re.MatchString(s)
+}
+
+// The separateStyle is a style in which words are written in the same
+// case and separated by a delimiter, such as snake_case. If upper is true,
+// words are written in upper case, such as SCREAMING_SNAKE_CASE.
+type separateStyle struct {
+	re        *regexp.Regexp
+	delimiter string
+	upper     bool
+}
+
+// Split splits a value into words at delimiters and digits.
+func (p separateStyle) Split(s string) []string {
+	return lowerAll(p.splitWith(s, defaultConverter))
+}
+
+// The splitWith splits a value into words as written in the value
+// with the tokenizer of the converter.
+func (p separateStyle) splitWith(s string, c *Converter) []string {
+	return c.Words(s)
+}
+
+// Join joins words with the delimiter.
+func (p separateStyle) Join(words []string) string {
+	if p.upper {
+		return strings.ToUpper(joinSeparate(words, p.delimiter))
+	}
+
+	return strings.ToLower(joinSeparate(words, p.delimiter))
+}
+
+// Is returns true if the value is written in the style.
+func (p separateStyle) Is(s string) bool {
+	return p.re.MatchString(s)
+}
+
+// The titledStyle is a style in which every word starts with a capital
+// letter and words are separated by a delimiter, such as Train-Case.
+// If titleOnly is true, abbreviations are capitalized as regular words,
+// such as the segments of Backslash\Case namespaces.
+type titledStyle struct {
+	re        *regexp.Regexp
+	delimiter string
+	titleOnly bool
+}
+
+// Split splits a value into words at delimiters, capital letters
+// and digits.
+func (t titledStyle) Split(s string) []string {
+	return lowerAll(t.splitWith(s, defaultConverter))
+}
+
+// The splitWith splits a value into words as written in the value
+// with the tokenizer of the converter.
+func (t titledStyle) splitWith(s string, c *Converter) []string {
+	return c.Words(s)
+}
+
+// Join joins capitalized words with the delimiter.
+func (t titledStyle) Join(words []string) string {
+	if t.titleOnly {
+		words = lowerAll(append([]string(nil), words...))
+	}
+
+	return joinTitled(words, t.delimiter)
+}
+
+// Is returns true if the value is written in the style.
+func (t titledStyle) Is(s string) bool {
+	return t.re.MatchString(s)
+}
@@ -0,0 +1,66 @@
+package scs
+
+import "testing"
+
+// TestStyleSplit tests Split method of the built-in styles.
+func TestStyleSplit(t *testing.T) {
+	tests := []struct {
+		style  Style
+		value  string
+		result []string
+	}{
+		{unitedStyle{isCamelCase, true}, "isHTTPOrHTTPS",
+			[]string{"is", "http", "or", "https"}},
+		{unitedStyle{isPascalCase, false}, "Ice9Cream",
+			[]string{"ice", "9", "cream"}},
+		{separateStyle{isSnakeCase, "_", false}, "one_two_three",
+			[]string{"one", "two", "three"}},
+		{separateStyle{isScreamingSnakeCase, "_", true}, "ONE_TWO",
+			[]string{"one", "two"}},
+		{titledStyle{isTrainCase, "-", false}, "X-Request-ID",
+			[]string{"x", "request", "id"}},
+	}
+
+	for i, test := range tests {
+		r := test.style.Split(test.value)
+		if len(r) != len(test.result) {
+			t.Fatalf("test for %d is failed, expected %v but %v",
+				i, test.result, r)
+		}
+
+		for j := range r {
+			if r[j] != test.result[j] {
+				t.Errorf("test for %d is failed, expected %v but %v",
+					i, test.result, r)
+			}
+		}
+	}
+}
+
+// TestStyleJoin tests Join method of the built-in styles.
+func TestStyleJoin(t *testing.T) {
+	words := []string{"HTTP", "to", "HTTPS", "9"}
+	tests := []struct {
+		style  Style
+		result string
+	}{
+		{unitedStyle{isCamelCase, true}, "httpToHTTPS9"},
+		{unitedStyle{isPascalCase, false}, "HTTPToHTTPS9"},
+		{separateStyle{isKebabCase, "-", false}, "http-to-https-9"},
+		{separateStyle{isScreamingSnakeCase, "_", true}, "HTTP_TO_HTTPS_9"},
+		{titledStyle{isTrainCase, "-", false}, "HTTP-To-HTTPS-9"},
+		{titledStyle{isBackslashCase, `\`, true}, `Http\To\Https\9`},
+	}
+
+	for i, test := range tests {
+		if r := test.style.Join(words); r != test.result {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, test.result, r)
+		}
+
+		if !test.style.Is(test.result) {
+			t.Errorf("test for %d is failed, %s isn't valid",
+				i, test.result)
+		}
+	}
+}
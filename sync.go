@@ -0,0 +1,430 @@
+package scs
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"sync"
+)
+
+// SyncStringCaseStyle is a StringCaseStyle object that is safe for
+// concurrent use by multiple goroutines. It can be created by the NewSync
+// function or by the Sync method of a StringCaseStyle object.
+//
+// It has the same methods as the StringCaseStyle object. Every method
+// holds the lock of the object for its whole duration, so conversions such
+// as ToCamel are atomic: readers never see a value that disagrees with
+// the style of the object. Methods that return copies of the object
+// return new SyncStringCaseStyle objects.
+//
+// Example usage:
+//
+//	style, _ := scs.NewSync(scs.Snake, "hello world")
+//	go style.Set("user name")
+//	go style.ToKebab()
+//	go fmt.Println(style.Snapshot()) // consistent style and value
+type SyncStringCaseStyle struct {
+	mu  sync.RWMutex
+	obj *StringCaseStyle
+}
+
+// NewSync returns a pointer to a concurrency-safe string case style
+// object. See the New function for details.
+//
+// Example usage:
+//
+//	style, err := scs.NewSync(scs.Camel, "hello", "world")
+//	// style.Value(): "helloWorld", err: nil
+func NewSync(style CaseStyle, value ...string) (*SyncStringCaseStyle, error) {
+	obj, err := New(style, value...)
+	return obj.Sync(), err
+}
+
+// Sync returns a concurrency-safe object with a copy of the object.
+// Changes of the returned object don't affect the original object.
+//
+// Example usage:
+//
+//	style, _ := New(Pascal, "http server")
+//	shared := style.Sync()
+//	shared.Value() // HTTPServer
+func (o *StringCaseStyle) Sync() *SyncStringCaseStyle {
+	obj := *o
+	return &SyncStringCaseStyle{obj: &obj}
+}
+
+// The read calls the function with the object under the read lock.
+func (s *SyncStringCaseStyle) read(fn func(o *StringCaseStyle)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.obj)
+}
+
+// The write calls the function with the object under the write lock.
+func (s *SyncStringCaseStyle) write(fn func(o *StringCaseStyle)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.obj)
+}
+
+// The copy wraps the copy of the object returned by the CopyTo method.
+func (s *SyncStringCaseStyle) copy(
+	fn func(o *StringCaseStyle) (*StringCaseStyle, error),
+) (*SyncStringCaseStyle, error) {
+	var obj *StringCaseStyle
+	var err error
+	s.read(func(o *StringCaseStyle) { obj, err = fn(o) })
+
+	return &SyncStringCaseStyle{obj: obj}, err
+}
+
+// Snapshot returns a copy of the object, which style and value
+// are taken at the same moment.
+func (s *SyncStringCaseStyle) Snapshot() *StringCaseStyle {
+	var obj StringCaseStyle
+	s.read(func(o *StringCaseStyle) { obj = *o })
+	return &obj
+}
+
+// IsValid returns true if the object was created correctly.
+func (s *SyncStringCaseStyle) IsValid() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsValid() })
+	return
+}
+
+// IsCamel returns true if the object represents a camelCase value.
+func (s *SyncStringCaseStyle) IsCamel() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsCamel() })
+	return
+}
+
+// IsKebab returns true if the object represents a kebab-case value.
+func (s *SyncStringCaseStyle) IsKebab() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsKebab() })
+	return
+}
+
+// IsPascal returns true if the object represents a PascalCase value.
+func (s *SyncStringCaseStyle) IsPascal() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsPascal() })
+	return
+}
+
+// IsSnake returns true if the object represents a snake_case value.
+func (s *SyncStringCaseStyle) IsSnake() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsSnake() })
+	return
+}
+
+// IsScreamingSnake returns true if the object represents a SCREAMING_SNAKE_CASE value.
+func (s *SyncStringCaseStyle) IsScreamingSnake() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsScreamingSnake() })
+	return
+}
+
+// IsTrain returns true if the object represents a Train-Case value.
+func (s *SyncStringCaseStyle) IsTrain() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsTrain() })
+	return
+}
+
+// IsDot returns true if the object represents a dot.case value.
+func (s *SyncStringCaseStyle) IsDot() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsDot() })
+	return
+}
+
+// IsPath returns true if the object represents a path/case value.
+func (s *SyncStringCaseStyle) IsPath() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsPath() })
+	return
+}
+
+// IsBackslash returns true if the object represents a Backslash\Case value.
+func (s *SyncStringCaseStyle) IsBackslash() (ok bool) {
+	s.read(func(o *StringCaseStyle) { ok = o.IsBackslash() })
+	return
+}
+
+// Eat converts the string to the style of the object, sets it
+// as the new value and returns it.
+func (s *SyncStringCaseStyle) Eat(v string) (value string) {
+	s.write(func(o *StringCaseStyle) { value = o.Eat(v) })
+	return
+}
+
+// Set converts the string to the style of the object and sets it as the
+// new value. The updated object is returned for method chaining.
+func (s *SyncStringCaseStyle) Set(v string) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.Set(v) })
+	return s
+}
+
+// Value returns the current value of the object.
+func (s *SyncStringCaseStyle) Value() (value string) {
+	s.read(func(o *StringCaseStyle) { value = o.Value() })
+	return
+}
+
+// Name returns the value of the object parsed into words.
+func (s *SyncStringCaseStyle) Name() (name Name) {
+	s.read(func(o *StringCaseStyle) { name = o.Name() })
+	return
+}
+
+// Words returns the words of the value of the object in natural spelling.
+func (s *SyncStringCaseStyle) Words() (words []string) {
+	s.read(func(o *StringCaseStyle) { words = o.Words() })
+	return
+}
+
+// Prepend adds the words before the value of the object.
+// The updated object is returned for method chaining.
+func (s *SyncStringCaseStyle) Prepend(words ...string) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.Prepend(words...) })
+	return s
+}
+
+// Append adds the words after the value of the object.
+// The updated object is returned for method chaining.
+func (s *SyncStringCaseStyle) Append(words ...string) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.Append(words...) })
+	return s
+}
+
+// TrimPrefixWord removes the first word of the value of the object if it
+// is one of the given words. The updated object is returned for method
+// chaining.
+func (s *SyncStringCaseStyle) TrimPrefixWord(
+	words ...string,
+) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.TrimPrefixWord(words...) })
+	return s
+}
+
+// TrimSuffixWord removes the last word of the value of the object if it
+// is one of the given words. The updated object is returned for method
+// chaining.
+func (s *SyncStringCaseStyle) TrimSuffixWord(
+	words ...string,
+) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.TrimSuffixWord(words...) })
+	return s
+}
+
+// ReplaceWord replaces all occurrences of the old words in the value
+// of the object with the new words. The updated object is returned for
+// method chaining.
+func (s *SyncStringCaseStyle) ReplaceWord(old, new string) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.ReplaceWord(old, new) })
+	return s
+}
+
+// Slice keeps the words of the value of the object from i to j.
+// The updated object is returned for method chaining.
+func (s *SyncStringCaseStyle) Slice(i, j int) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.Slice(i, j) })
+	return s
+}
+
+// SetProfile sets the profile of the abbreviations dictionary and
+// re-renders the value. The updated object is returned for method chaining.
+func (s *SyncStringCaseStyle) SetProfile(p Profile) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.SetProfile(p) })
+	return s
+}
+
+// SetAcronymPolicy sets the acronym policy and re-renders the value.
+// The updated object is returned for method chaining.
+func (s *SyncStringCaseStyle) SetAcronymPolicy(
+	p AcronymPolicy,
+) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.SetAcronymPolicy(p) })
+	return s
+}
+
+// SetNumberPolicy sets the number policy and re-renders the value.
+// The updated object is returned for method chaining.
+func (s *SyncStringCaseStyle) SetNumberPolicy(
+	p NumberPolicy,
+) *SyncStringCaseStyle {
+	s.write(func(o *StringCaseStyle) { o.SetNumberPolicy(p) })
+	return s
+}
+
+// CopyTo converts a copy of the object to the given style
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyTo(
+	style CaseStyle,
+) (*SyncStringCaseStyle, error) {
+	return s.copy(func(o *StringCaseStyle) (*StringCaseStyle, error) {
+		return o.CopyTo(style)
+	})
+}
+
+// To converts the object to the given style. The style and the value
+// are changed at once, and the object remains unchanged if the conversion
+// fails.
+func (s *SyncStringCaseStyle) To(style CaseStyle) (err error) {
+	s.write(func(o *StringCaseStyle) { err = o.To(style) })
+	return
+}
+
+// CopyToCamel converts a copy of the object to camelCase
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyToCamel() (*SyncStringCaseStyle, error) {
+	return s.CopyTo(Camel)
+}
+
+// ToCamel converts the object to camelCase.
+func (s *SyncStringCaseStyle) ToCamel() error {
+	return s.To(Camel)
+}
+
+// CopyToKebab converts a copy of the object to kebab-case
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyToKebab() (*SyncStringCaseStyle, error) {
+	return s.CopyTo(Kebab)
+}
+
+// ToKebab converts the object to kebab-case.
+func (s *SyncStringCaseStyle) ToKebab() error {
+	return s.To(Kebab)
+}
+
+// CopyToPascal converts a copy of the object to PascalCase
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyToPascal() (*SyncStringCaseStyle, error) {
+	return s.CopyTo(Pascal)
+}
+
+// ToPascal converts the object to PascalCase.
+func (s *SyncStringCaseStyle) ToPascal() error {
+	return s.To(Pascal)
+}
+
+// CopyToSnake converts a copy of the object to snake_case
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyToSnake() (*SyncStringCaseStyle, error) {
+	return s.CopyTo(Snake)
+}
+
+// ToSnake converts the object to snake_case.
+func (s *SyncStringCaseStyle) ToSnake() error {
+	return s.To(Snake)
+}
+
+// CopyToScreamingSnake converts a copy of the object to SCREAMING_SNAKE_CASE
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyToScreamingSnake() (*SyncStringCaseStyle, error) {
+	return s.CopyTo(ScreamingSnake)
+}
+
+// ToScreamingSnake converts the object to SCREAMING_SNAKE_CASE.
+func (s *SyncStringCaseStyle) ToScreamingSnake() error {
+	return s.To(ScreamingSnake)
+}
+
+// CopyToTrain converts a copy of the object to Train-Case
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyToTrain() (*SyncStringCaseStyle, error) {
+	return s.CopyTo(Train)
+}
+
+// ToTrain converts the object to Train-Case.
+func (s *SyncStringCaseStyle) ToTrain() error {
+	return s.To(Train)
+}
+
+// CopyToDot converts a copy of the object to dot.case
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyToDot() (*SyncStringCaseStyle, error) {
+	return s.CopyTo(Dot)
+}
+
+// ToDot converts the object to dot.case.
+func (s *SyncStringCaseStyle) ToDot() error {
+	return s.To(Dot)
+}
+
+// CopyToPath converts a copy of the object to path/case
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyToPath() (*SyncStringCaseStyle, error) {
+	return s.CopyTo(Path)
+}
+
+// ToPath converts the object to path/case.
+func (s *SyncStringCaseStyle) ToPath() error {
+	return s.To(Path)
+}
+
+// CopyToBackslash converts a copy of the object to Backslash\Case
+// and returns new pointer to it.
+func (s *SyncStringCaseStyle) CopyToBackslash() (*SyncStringCaseStyle, error) {
+	return s.CopyTo(Backslash)
+}
+
+// ToBackslash converts the object to Backslash\Case.
+func (s *SyncStringCaseStyle) ToBackslash() error {
+	return s.To(Backslash)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s *SyncStringCaseStyle) MarshalText() (text []byte, err error) {
+	s.read(func(o *StringCaseStyle) { text, err = o.MarshalText() })
+	return
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text is converted to the style of the object.
+func (s *SyncStringCaseStyle) UnmarshalText(text []byte) (err error) {
+	s.write(func(o *StringCaseStyle) { err = o.UnmarshalText(text) })
+	return
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *SyncStringCaseStyle) MarshalJSON() (data []byte, err error) {
+	s.read(func(o *StringCaseStyle) { data, err = o.MarshalJSON() })
+	return
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The JSON string is converted to the style of the object.
+func (s *SyncStringCaseStyle) UnmarshalJSON(data []byte) (err error) {
+	s.write(func(o *StringCaseStyle) { err = o.UnmarshalJSON(data) })
+	return
+}
+
+// Scan implements the sql.Scanner interface.
+// The value is converted to the style of the object.
+func (s *SyncStringCaseStyle) Scan(src any) (err error) {
+	s.write(func(o *StringCaseStyle) { err = o.Scan(src) })
+	return
+}
+
+// The syncValuer is a driver.Valuer of the concurrency-safe object.
+type syncValuer struct {
+	s *SyncStringCaseStyle
+}
+
+// Value implements the driver.Valuer interface.
+func (v syncValuer) Value() (driver.Value, error) {
+	return v.s.Value(), nil
+}
+
+// Valuer returns the driver.Valuer of the object, which passes
+// the current value of the object to database drivers.
+func (s *SyncStringCaseStyle) Valuer() driver.Valuer {
+	return syncValuer{s}
+}
+
+// String implements the fmt.Stringer interface.
+// It returns the value of the object.
+func (s *SyncStringCaseStyle) String() string {
+	return s.Value()
+}
+
+// Format implements the fmt.Formatter interface.
+// See the Format method of the StringCaseStyle for details.
+func (s *SyncStringCaseStyle) Format(f fmt.State, verb rune) {
+	s.Snapshot().Format(f, verb)
+}
@@ -0,0 +1,110 @@
+package scs
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+)
+
+// TestNewSync tests NewSync function and methods of the object.
+func TestNewSync(t *testing.T) {
+	style, err := NewSync(Snake, "hello world")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := style.Value(); v != "hello_world" || !style.IsSnake() {
+		t.Errorf("expected hello_world but %s", v)
+	}
+
+	if err := style.ToPascal(); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := style.Set("http server").Value(); v != "HTTPServer" {
+		t.Errorf("expected HTTPServer but %s", v)
+	}
+
+	kebab, err := style.CopyToKebab()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := kebab.Value(); v != "http-server" || !style.IsPascal() {
+		t.Errorf("expected http-server but %s", v)
+	}
+
+	if v := style.SetAcronymPolicy(TitleOnly).Value(); v != "HttpServer" {
+		t.Errorf("expected HttpServer but %s", v)
+	}
+
+	style.SetAcronymPolicy(UpperAll).TrimSuffixWord("server").Append("id")
+	if v := style.Value(); v != "HTTPID" {
+		t.Errorf("expected HTTPID but %s", v)
+	}
+
+	if _, err := NewSync(0, "hello"); err == nil {
+		t.Error("there must be an error")
+	}
+}
+
+// TestObjSync tests that the concurrency-safe object has a copy
+// of the original object.
+func TestObjSync(t *testing.T) {
+	obj, _ := New(Camel, "hello world")
+	style := obj.Sync()
+	style.Set("user name")
+
+	if v := obj.Value(); v != "helloWorld" {
+		t.Errorf("expected helloWorld but %s", v)
+	}
+
+	data, err := json.Marshal(style)
+	if err != nil || string(data) != `"userName"` {
+		t.Errorf("expected \"userName\" but %s (%v)", data, err)
+	}
+}
+
+// TestSyncRace tests concurrent changes and reads of the object.
+// Run it with the race detector: go test -race.
+func TestSyncRace(t *testing.T) {
+	style, _ := NewSync(Snake, "hello world")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				switch j % 4 {
+				case 0:
+					style.ToCamel()
+				case 1:
+					style.ToKebab()
+				case 2:
+					style.Set("http server")
+				case 3:
+					style.Eat("user id")
+				}
+			}
+		}(i)
+
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				// The style and the value must agree.
+				obj := style.Snapshot()
+				e, _ := lookup(obj.style)
+				if !e.style.Is(obj.Value()) {
+					t.Errorf("value %s isn't %s style", obj.Value(), e.name)
+				}
+
+				_ = style.Value()
+				_ = style.IsCamel()
+				_ = style.String()
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,347 @@
+package scs
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind is the kind of a token.
+type TokenKind uint8
+
+const (
+	// TokenWord is a word, such as "hello" or "World".
+	TokenWord TokenKind = iota
+
+	// TokenAcronym is a word written in capital letters, such as "HTTP",
+	// or an abbreviation joined from several parts, such as "IDs" or
+	// "WiFi".
+	TokenAcronym
+
+	// TokenNumber is a sequence of digits, such as "2".
+	TokenNumber
+
+	// TokenSeparator is a sequence of characters that are neither letters
+	// nor digits, such as "_", "-" or " ".
+	TokenSeparator
+)
+
+// Token is a part of a string produced by the Tokenize function.
+type Token struct {
+	Text  string    // text of the token as written in the input
+	Kind  TokenKind // kind of the token
+	Start int       // byte offset of the token start in the input
+	End   int       // byte offset of the token end in the input
+}
+
+// The charClass is a class of the character used to find word boundaries.
+type charClass uint8
+
+const (
+	classSeparator charClass = iota
+	classLower
+	classUpper
+	classDigit
+)
+
+// The char is a character of the tokenized string.
+type char struct {
+	class charClass
+	pos   int
+}
+
+// The classify returns the class of the character.
+func classify(r rune) charClass {
+	switch {
+	case unicode.IsUpper(r):
+		return classUpper
+	case unicode.IsLetter(r):
+		return classLower
+	case unicode.IsNumber(r):
+		return classDigit
+	}
+
+	return classSeparator
+}
+
+// The isBoundary returns true if a new token starts at the i-th character.
+func isBoundary(chars []char, i int) bool {
+	prev, cur := chars[i-1].class, chars[i].class
+	switch {
+	case prev == cur:
+		// The last capital letter of an abbreviation starts a new word,
+		// for example: HTTPServer -> HTTP Server.
+		return cur == classUpper && i+1 < len(chars) &&
+			chars[i+1].class == classLower
+	case prev == classUpper && cur == classLower:
+		return false
+	}
+
+	return true
+}
+
+// The kindOf returns the kind of the token with the given text.
+func kindOf(text string, class charClass) TokenKind {
+	switch class {
+	case classSeparator:
+		return TokenSeparator
+	case classDigit:
+		return TokenNumber
+	}
+
+	if utf8.RuneCountInString(text) > 1 && isUpperText(text) {
+		return TokenAcronym
+	}
+
+	return TokenWord
+}
+
+// The isUpperText returns true if the text doesn't change in upper case.
+// It's the strings.ToUpper(text) == text without allocating the copy.
+func isUpperText(text string) bool {
+	for _, r := range text {
+		if unicode.ToUpper(r) != r {
+			return false
+		}
+	}
+
+	return true
+}
+
+// The merge returns a single token made of the adjacent tokens.
+func merge(s string, tokens []Token) Token {
+	start, end := tokens[0].Start, tokens[len(tokens)-1].End
+	return Token{
+		Text:  s[start:end],
+		Kind:  kindOf(s[start:end], classLower),
+		Start: start,
+		End:   end,
+	}
+}
+
+// The joinKnown joins adjacent tokens that form a single abbreviation
+// of the dictionary, such as "UTF", "8" -> "UTF8" or "Wi", "Fi" -> "WiFi",
+// and plural abbreviations, such as "I", "Ds" -> "IDs". The tokens are
+// joined in place and the caller must hold the read lock of dictionary.
+func joinKnown(s string, tokens []Token, d *dictionary) []Token {
+	const maxParts = 3
+
+	result := tokens[:0]
+	for i := 0; i < len(tokens); i++ {
+		n := 1
+		for j := i + 2; j <= i+maxParts && j <= len(tokens); j++ {
+			if tokens[j-1].Kind == TokenSeparator {
+				break
+			}
+
+			text := s[tokens[i].Start:tokens[j-1].End]
+			if v, ok := d.find(text); ok &&
+				(v == text || strings.IndexFunc(v, unicode.IsDigit) >= 0) {
+				n = j - i
+			}
+		}
+
+		// Plural abbreviation: the last capital letter of the abbreviation
+		// and the "s" ending were split into a separate word.
+		if n == 1 && i+1 < len(tokens) {
+			cur, next := tokens[i], tokens[i+1]
+			if cur.Kind != TokenSeparator && cur.Kind != TokenNumber &&
+				isUpperText(cur.Text) &&
+				len(next.Text) == 2 && next.Text[1] == 's' &&
+				d.contains(s[cur.Start:next.Start+1]) {
+				n = 2
+			}
+		}
+
+		if n == 1 {
+			result = append(result, tokens[i])
+			continue
+		}
+
+		// The joined token is an abbreviation even if it isn't written
+		// in capitals, such as "IDs" or "WiFi".
+		token := merge(s, tokens[i:i+n])
+		token.Kind = TokenAcronym
+		result = append(result, token)
+		i += n - 1
+	}
+
+	return result
+}
+
+// The appendKnown appends the token to the tokens, splitting a run
+// of capital letters into the abbreviations of the dictionary, such as
+// "HTTPSURL" -> "HTTPS", "URL". The run is split only if it consists
+// entirely of known abbreviations and isn't an abbreviation itself.
+// The caller must hold the read lock of the dictionary.
+func appendKnown(tokens []Token, token Token, d *dictionary) []Token {
+	if token.Kind != TokenAcronym || d.contains(token.Text) {
+		return append(tokens, token)
+	}
+
+	// The best[i] is the minimum number of abbreviations that make up
+	// the first i bytes of the token, the cut[i] is where the last one
+	// of them starts. The isAbbreviationSpelling allows only latin
+	// letters and digits in the abbreviations, so the token can be cut
+	// at any byte, and no abbreviation with its plural ending is longer
+	// than maxLen+2 bytes.
+	var bestBuf, cutBuf [32]int
+	text := token.Text
+	best, cut := bestBuf[:0], cutBuf[:0]
+	if len(text) >= len(bestBuf) {
+		best, cut = make([]int, 0, len(text)+1), make([]int, 0, len(text)+1)
+	}
+
+	best, cut = append(best, 0), append(cut, 0)
+	for i := 1; i <= len(text); i++ {
+		best, cut = append(best, -1), append(cut, 0)
+		j := i - d.maxLen - len("es")
+		if j < 0 {
+			j = 0
+		}
+
+		for ; j < i-1; j++ {
+			if best[j] < 0 || !d.contains(text[j:i]) {
+				continue
+			}
+
+			if best[i] < 0 || best[j]+1 < best[i] {
+				best[i], cut[i] = best[j]+1, j
+			}
+		}
+	}
+
+	if best[len(text)] < 0 {
+		return append(tokens, token)
+	}
+
+	for i := 0; i < best[len(text)]; i++ {
+		tokens = append(tokens, Token{})
+	}
+
+	for i, k := len(text), len(tokens)-1; i > 0; i, k = cut[i], k-1 {
+		tokens[k] = Token{
+			Text:  text[cut[i]:i],
+			Kind:  TokenAcronym,
+			Start: token.Start + cut[i],
+			End:   token.Start + i,
+		}
+	}
+
+	return tokens
+}
+
+// The tokenize splits the string into tokens using the dictionary.
+func tokenize(s string, d *dictionary) []Token {
+	if s == "" {
+		return nil
+	}
+
+	// Most strings are short, so the characters are kept in a buffer
+	// on the stack.
+	var buf [64]char
+	chars := buf[:0]
+	for pos, r := range s {
+		chars = append(chars, char{classify(r), pos})
+	}
+
+	// Runs of capitals are split at abbreviations only in mixed-case
+	// strings and in single words, since the words of the strings such
+	// as SCREAMING_SNAKE_CASE are already separated.
+	hasLower, hasSeparator, n := false, false, 1
+	for i, c := range chars {
+		hasLower = hasLower || c.class == classLower
+		hasSeparator = hasSeparator || c.class == classSeparator
+		if i > 0 && c.class != chars[i-1].class {
+			n++
+		}
+	}
+
+	d.RLock()
+	defer d.RUnlock()
+
+	tokens := make([]Token, 0, n)
+	start := 0
+	for i := 1; i <= len(chars); i++ {
+		if i < len(chars) && !isBoundary(chars, i) {
+			continue
+		}
+
+		end := len(s)
+		if i < len(chars) {
+			end = chars[i].pos
+		}
+
+		text := s[chars[start].pos:end]
+		token := Token{
+			Text:  text,
+			Kind:  kindOf(text, chars[start].class),
+			Start: chars[start].pos,
+			End:   end,
+		}
+
+		if hasLower || !hasSeparator {
+			tokens = appendKnown(tokens, token, d)
+		} else {
+			tokens = append(tokens, token)
+		}
+
+		start = i
+	}
+
+	return joinKnown(s, tokens, d)
+}
+
+// Tokenize splits the string into tokens.
+//
+// This function is the tokenizer used by all conversion functions of the
+// package, so the words it returns are exactly the words the converters
+// work with. A new token starts:
+//
+//   - at any transition between letters, digits and other characters;
+//   - at a capital letter that follows a lowercase letter (helloWorld);
+//   - at the last capital letter of an abbreviation that is followed by
+//     a lowercase letter (HTTPServer -> HTTP, Server).
+//
+// The abbreviations dictionary refines the result: adjacent parts that
+// form a known abbreviation are kept together (UTF8, WiFi), plural
+// abbreviations keep their ending (IDs, URLs) and a run of capitals made
+// entirely of known abbreviations is split into them (HTTPSURL -> HTTPS,
+// URL), unless the string is written in capitals with separators, such
+// as SCREAMING_SNAKE_CASE, where the words are already separated.
+//
+// Each token carries its text, its kind and the byte offsets of the token
+// in the input string, so s[t.Start:t.End] == t.Text.
+//
+// Example usage:
+//
+//	scs.Tokenize("parseHTTP2Request")
+//	// [{parse TokenWord 0 5} {HTTP TokenAcronym 5 9}
+//	//  {2 TokenNumber 9 10} {Request TokenWord 10 17}]
+func Tokenize(s string) []Token {
+	return defaultConverter.Tokenize(s)
+}
+
+// Words returns the words of the string as written in the input,
+// ignoring separators. The words are split in the same way as by
+// the Tokenize function.
+//
+// Example usage:
+//
+//	scs.Words("parseHTTP2Request") // [parse HTTP 2 Request]
+//	scs.Words("max_open-conns")    // [max open conns]
+func Words(s string) []string {
+	return defaultConverter.Words(s)
+}
+
+// The textOf returns the texts of the tokens, ignoring separators.
+func textOf(tokens []Token) []string {
+	words := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		if t.Kind != TokenSeparator {
+			words = append(words, t.Text)
+		}
+	}
+
+	return words
+}
@@ -0,0 +1,164 @@
+package scs
+
+import "testing"
+
+// TestTokenize tests Tokenize function.
+func TestTokenize(t *testing.T) {
+	s := "parseHTTP2Request, ok"
+	expected := []Token{
+		{"parse", TokenWord, 0, 5},
+		{"HTTP", TokenAcronym, 5, 9},
+		{"2", TokenNumber, 9, 10},
+		{"Request", TokenWord, 10, 17},
+		{", ", TokenSeparator, 17, 19},
+		{"ok", TokenWord, 19, 21},
+	}
+
+	tokens := Tokenize(s)
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %v but %v", expected, tokens)
+	}
+
+	for i, token := range tokens {
+		if token != expected[i] {
+			t.Errorf("expected %v but %v", expected[i], token)
+		}
+
+		if r := s[token.Start:token.End]; r != token.Text {
+			t.Errorf("expected %s but %s", token.Text, r)
+		}
+	}
+
+	if tokens := Tokenize(""); len(tokens) != 0 {
+		t.Errorf("expected no tokens but %v", tokens)
+	}
+}
+
+// TestTokenizeAbbreviations tests the kinds of the tokens joined into
+// the abbreviations of the dictionary.
+func TestTokenizeAbbreviations(t *testing.T) {
+	tests := []struct {
+		value  string
+		result Token
+	}{
+		{"userIDs", Token{"IDs", TokenAcronym, 4, 7}},
+		{"listURLs", Token{"URLs", TokenAcronym, 4, 8}},
+		{"useWiFi", Token{"WiFi", TokenAcronym, 3, 7}},
+		{"userIds", Token{"Ids", TokenWord, 4, 7}},
+	}
+
+	for i, test := range tests {
+		tokens := Tokenize(test.value)
+		if r := tokens[len(tokens)-1]; r != test.result {
+			t.Errorf("test for %d is failed, expected %v but %v",
+				i, test.result, r)
+		}
+	}
+}
+
+// TestTokenizeUnicode tests Tokenize function with multibyte characters.
+func TestTokenizeUnicode(t *testing.T) {
+	s := "привітСвіт_42"
+	expected := []string{"привіт", "Світ", "_", "42"}
+
+	tokens := Tokenize(s)
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %v but %v", expected, tokens)
+	}
+
+	for i, token := range tokens {
+		if token.Text != expected[i] {
+			t.Errorf("expected %s but %s", expected[i], token.Text)
+		}
+
+		if r := s[token.Start:token.End]; r != token.Text {
+			t.Errorf("expected %s but %s", token.Text, r)
+		}
+	}
+}
+
+// TestWords tests Words function.
+func TestWords(t *testing.T) {
+	tests := []struct {
+		value  string
+		result []string
+	}{
+		{"helloWorld", []string{"hello", "World"}},
+		{"HTTPServer", []string{"HTTP", "Server"}},
+		{"isWWWConnection", []string{"is", "WWW", "Connection"}},
+		{"web2print", []string{"web", "2", "print"}},
+		{"max_open-conns", []string{"max", "open", "conns"}},
+		{" -_ ", []string{}},
+		{"XMLHttpRequest", []string{"XML", "Http", "Request"}},
+		{"userIDsMap", []string{"user", "IDs", "Map"}},
+		{"getHTTPSURL", []string{"get", "HTTPS", "URL"}},
+		{"HTTPSURL", []string{"HTTPS", "URL"}},
+		{"HTTPSURL_KEY", []string{"HTTPSURL", "KEY"}},
+		{"WiFiRouter", []string{"WiFi", "Router"}},
+		{"iSCSITarget", []string{"iSCSI", "Target"}},
+		{"CATALOG", []string{"CATALOG"}},
+	}
+
+	for i, test := range tests {
+		r := Words(test.value)
+		if len(r) != len(test.result) {
+			t.Fatalf("test for %d is failed, expected %v but %v",
+				i, test.result, r)
+		}
+
+		for j := range r {
+			if r[j] != test.result[j] {
+				t.Errorf("test for %d is failed, expected %v but %v",
+					i, test.result, r)
+			}
+		}
+	}
+}
+
+// TestConsistentSplitting tests that all converters split words
+// in the same way.
+func TestConsistentSplitting(t *testing.T) {
+	kebab, err := CamelToKebab("web2print")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r := StrToKebab("web2print"); r != kebab {
+		t.Errorf("expected %s but %s", kebab, r)
+	}
+
+	if r := ToKebab("web2print"); r != kebab {
+		t.Errorf("expected %s but %s", kebab, r)
+	}
+}
+
+// TestAcronymBoundaries tests conversion of the strings that contain
+// runs of capital letters.
+func TestAcronymBoundaries(t *testing.T) {
+	tests := []struct {
+		value  string
+		snake  string
+		pascal string
+	}{
+		{"HTTPServer", "http_server", "HTTPServer"},
+		{"XMLHttpRequest", "xml_http_request", "XMLHTTPRequest"},
+		{"IDs", "ids", "IDs"},
+		{"parseURLsFast", "parse_urls_fast", "ParseURLsFast"},
+		{"helloWorld", "hello_world", "HelloWorld"},
+		{"getHTTPSURL", "get_https_url", "GetHTTPSURL"},
+		{"HTTP2HTTPSConvertor", "http_2_https_convertor",
+			"HTTP2HTTPSConvertor"},
+	}
+
+	for i, test := range tests {
+		if r := StrToSnake(test.value); r != test.snake {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.snake, r)
+		}
+
+		if r := StrToPascal(test.value); r != test.pascal {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.pascal, r)
+		}
+	}
+}
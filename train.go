@@ -0,0 +1,148 @@
+package scs
+
+import "regexp"
+
+// The titledWord is a word of the styles with capitalized words, such
+// as Train-Case: a capitalized word or an abbreviation in upper case,
+// which can have the plural ending ("Hello", "ID", "IDs").
+const titledWord = `([A-Z0-9][a-z0-9]*|[A-Z0-9]+(e?s)?)`
+
+var (
+	isTrainCase = regexp.MustCompile(
+		`^` + titledWord + `(-` + titledWord + `)*$`,
+	)
+
+	isTitledWord = regexp.MustCompile(`^` + titledWord + `$`)
+)
+
+// StrIsTrain returns true if the string is in Train-Case.
+//
+// Train-Case (also known as HTTP-Header-Case) is a naming convention in
+// which words are separated by hyphens and each word starts with an
+// uppercase letter or a digit. Abbreviations may be written in upper case,
+// but a word can't contain other capital letters, so "HelloWorld" is
+// PascalCase rather than Train-Case.
+//
+// Example usage:
+//
+//	scs.StrIsTrain("Content-Type") // returns true
+//	scs.StrIsTrain("X-Request-ID") // returns true
+//	scs.StrIsTrain("content-type") // returns false
+//	scs.StrIsTrain("ContentType")  // returns false
+//	scs.StrIsTrain("Content_Type") // returns false
+func StrIsTrain(s string) bool {
+	return isTrainCase.Match([]byte(s))
+}
+
+// StrToTrain converts a string to Train-Case.
+//
+// This function splits the input string into words, capitalizes each word
+// and joins them with hyphens. Words found in the abbreviations dictionary
+// are written as abbreviations, so the result is suitable for canonical
+// HTTP header names.
+//
+// Example usage:
+//
+//	scs.StrToTrain("content type")     // returns "Content-Type"
+//	scs.StrToTrain("x_api_key")        // returns "X-API-Key"
+//	scs.StrToTrain("www authenticate") // returns "WWW-Authenticate"
+func StrToTrain(s string) string {
+	return defaultConverter.StrToTrain(s)
+}
+
+// ToTrain converts a string to Train-Case.
+//
+// Unlike the StrToTrain function, if the source string already has
+// a certain format such as camelCase, kebab-case, PascalCase, snake_case
+// or SCREAMING_SNAKE_CASE, it will be correctly converted to Train-Case.
+//
+// Example usage:
+//
+//	scs.ToTrain("contentType")  // returns "Content-Type"
+//	scs.ToTrain("XRequestID")   // returns "X-Request-ID"
+//	scs.ToTrain("x-api-key")    // returns "X-API-Key"
+//	scs.ToTrain("X_API_KEY")    // returns "X-API-Key"
+func ToTrain(s string) string {
+	return defaultConverter.ToTrain(s)
+}
+
+// TrainToCamel converts a Train-Case-style string to camelCase.
+//
+// This function checks if the input string is in Train-Case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := TrainToCamel("X-Request-ID")
+//	// result: "xRequestID", err: nil
+//
+//	result, err := TrainToCamel("x-request-id")
+//	// result: "", err: error (not Train-Case)
+func TrainToCamel(train string) (string, error) {
+	return defaultConverter.convert(train, Train, Camel)
+}
+
+// TrainToKebab converts a Train-Case-style string to kebab-case.
+//
+// This function checks if the input string is in Train-Case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := TrainToKebab("X-Request-ID")
+//	// result: "x-request-id", err: nil
+//
+//	result, err := TrainToKebab("XRequestID")
+//	// result: "", err: error (not Train-Case)
+func TrainToKebab(train string) (string, error) {
+	return defaultConverter.convert(train, Train, Kebab)
+}
+
+// TrainToPascal converts a Train-Case-style string to PascalCase.
+//
+// This function checks if the input string is in Train-Case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := TrainToPascal("X-Request-ID")
+//	// result: "XRequestID", err: nil
+//
+//	result, err := TrainToPascal("x_request_id")
+//	// result: "", err: error (not Train-Case)
+func TrainToPascal(train string) (string, error) {
+	return defaultConverter.convert(train, Train, Pascal)
+}
+
+// TrainToSnake converts a Train-Case-style string to snake_case.
+//
+// This function checks if the input string is in Train-Case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := TrainToSnake("X-Request-ID")
+//	// result: "x_request_id", err: nil
+//
+//	result, err := TrainToSnake("x-request-id")
+//	// result: "", err: error (not Train-Case)
+func TrainToSnake(train string) (string, error) {
+	return defaultConverter.convert(train, Train, Snake)
+}
+
+// TrainToScreamingSnake converts a Train-Case-style string
+// to SCREAMING_SNAKE_CASE.
+//
+// This function checks if the input string is in Train-Case. If it's not,
+// it returns an error.
+//
+// Example usage:
+//
+//	result, err := TrainToScreamingSnake("X-Request-ID")
+//	// result: "X_REQUEST_ID", err: nil
+//
+//	result, err := TrainToScreamingSnake("x-request-id")
+//	// result: "", err: error (not Train-Case)
+func TrainToScreamingSnake(train string) (string, error) {
+	return defaultConverter.convert(train, Train, ScreamingSnake)
+}
@@ -0,0 +1,144 @@
+package scs
+
+import "testing"
+
+// TestStrIsTrain tests StrIsTrain function.
+func TestStrIsTrain(t *testing.T) {
+	tests := []struct {
+		value  string
+		result bool
+	}{
+		// Simple examples
+		{"One", true},
+		{"one", false},
+		{"One-Two-Three", true},
+		{"One-two-Three", false},
+		{"One_Two_Three", false},
+		{"Ice-9", true},
+
+		// Examples with abbreviations
+		{"Content-Type", true},
+		{"X-Request-ID", true},
+		{"WWW-Authenticate", true},
+		{"X--Request", false},
+		{"-X-Request", false},
+	}
+
+	for _, s := range tests {
+		if r := StrIsTrain(s.value); s.result != r {
+			t.Errorf("test for `%s` is failed, "+
+				"expected %t but %t", s.value, s.result, r)
+		}
+	}
+}
+
+// TestStrToTrain tests StrToTrain function.
+func TestStrToTrain(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		// Simple examples
+		{"One", "One"},
+		{" One two Three ", "One-Two-Three"},
+		{"Ice 9", "Ice-9"},
+
+		// Examples with abbreviations
+		{"content type", "Content-Type"},
+		{"x api key", "X-API-Key"},
+		{"x_request_id", "X-Request-ID"},
+		{"www authenticate", "WWW-Authenticate"},
+	}
+
+	for i, s := range tests {
+		if r := StrToTrain(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestToTrain tests ToTrain function.
+func TestToTrain(t *testing.T) {
+	tests := []struct {
+		value  string
+		result string
+	}{
+		{"contentType", "Content-Type"},
+		{"XRequestID", "X-Request-ID"},
+		{"x-api-key", "X-API-Key"},
+		{"x_api_key", "X-API-Key"},
+		{"X_API_KEY", "X-API-Key"},
+		{"X-Api-Key", "X-Api-Key"},
+		{"x api key", "X-API-Key"},
+	}
+
+	for i, s := range tests {
+		if r := ToTrain(s.value); s.result != r {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+}
+
+// TestTrainTo tests TrainTo* functions.
+func TestTrainTo(t *testing.T) {
+	tests := []struct {
+		fn     func(string) (string, error)
+		value  string
+		result string
+	}{
+		{TrainToCamel, "X-Request-ID", "xRequestID"},
+		{TrainToKebab, "X-Request-ID", "x-request-id"},
+		{TrainToPascal, "X-Request-ID", "XRequestID"},
+		{TrainToSnake, "X-Request-ID", "x_request_id"},
+		{TrainToScreamingSnake, "X-Request-ID", "X_REQUEST_ID"},
+	}
+
+	for i, s := range tests {
+		r, err := s.fn(s.value)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r != s.result {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+
+	if _, err := TrainToKebab("x-request-id"); err == nil {
+		t.Error("not train to kebab")
+	}
+}
+
+// TestToTrainFrom tests *ToTrain functions.
+func TestToTrainFrom(t *testing.T) {
+	tests := []struct {
+		fn     func(string) (string, error)
+		value  string
+		result string
+	}{
+		{CamelToTrain, "xRequestID", "X-Request-ID"},
+		{KebabToTrain, "x-request-id", "X-Request-ID"},
+		{PascalToTrain, "XRequestID", "X-Request-ID"},
+		{SnakeToTrain, "x_request_id", "X-Request-ID"},
+		{ScreamingSnakeToTrain, "X_REQUEST_ID", "X-Request-ID"},
+	}
+
+	for i, s := range tests {
+		r, err := s.fn(s.value)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r != s.result {
+			t.Errorf("test for %d is failed, "+
+				"expected %s but %s", i, s.result, r)
+		}
+	}
+
+	if _, err := KebabToTrain("X-Request-ID"); err == nil {
+		t.Error("not kebab to train")
+	}
+}
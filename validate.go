@@ -0,0 +1,330 @@
+package scs
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Violation describes a part of a string that breaks the rules
+// of a case style.
+type Violation struct {
+	Offset  int    // byte offset of the violation in the string
+	End     int    // byte offset of the end of the violation
+	Rule    string // rule ID, such as "uppercase-in-snake"
+	Message string // human-readable description of the violation
+	Fix     string // suggested replacement of the s[Offset:End]
+}
+
+// String returns the violation in the "offset: rule: message" format.
+func (v Violation) String() string {
+	return fmt.Sprintf("%d: %s: %s", v.Offset, v.Rule, v.Message)
+}
+
+// The letterCase is the case of letters required by a style.
+type letterCase uint8
+
+const (
+	anyLetters letterCase = iota
+	lowerLetters
+	upperLetters
+)
+
+// The wordStart is the rule for the first letter of words of a style.
+type wordStart uint8
+
+const (
+	anyStart     wordStart = iota
+	lowerStart             // the value starts with a lowercase letter
+	upperStart             // the value starts with a capital letter
+	titledStarts           // every word starts with a capital or a digit
+)
+
+// The validation describes the rules of a built-in style.
+type validation struct {
+	name      string     // short name of the style used in rule IDs
+	delimiter rune       // delimiter of words, zero for united styles
+	letters   letterCase // case of the letters
+	start     wordStart  // rule for the first letter of words
+	noLeading bool       // the value can't start with the delimiter
+	strict    bool       // no leading, trailing or consecutive delimiters
+	joiner    rune       // delimiter of kebab words inside segments, if any
+}
+
+// The validations contains the rules of the built-in styles.
+var validations = map[CaseStyle]validation{
+	Camel:          {"camel", 0, anyLetters, lowerStart, false, false, 0},
+	Pascal:         {"pascal", 0, anyLetters, upperStart, false, false, 0},
+	Kebab:          {"kebab", '-', lowerLetters, anyStart, true, false, 0},
+	Snake:          {"snake", '_', lowerLetters, anyStart, false, false, 0},
+	ScreamingSnake: {"screaming-snake", '_', upperLetters, anyStart, false, false, 0},
+	Train:          {"train", '-', anyLetters, titledStarts, true, true, 0},
+	Dot:            {"dot", '.', lowerLetters, anyStart, true, true, 0},
+	Path:           {"path", '/', lowerLetters, anyStart, true, true, '-'},
+	Backslash:      {"backslash", '\\', anyLetters, titledStarts, true, true, 0},
+}
+
+// The isSeparatorRune returns true if the rune is commonly used
+// as a delimiter of words.
+func isSeparatorRune(r rune) bool {
+	return strings.ContainsRune(" _-./\\", r)
+}
+
+// The isASCIIAlnum returns true if the rune is a latin letter or a digit.
+func isASCIIAlnum(r rune) bool {
+	return r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// The check returns the violations of the rules in the string.
+func (v validation) check(s string) []Violation {
+	// The delta is the difference between the lengths of the string
+	// with the fixes applied and the string itself up to the current
+	// position, so the rules of the value start are checked against
+	// the corrected prefix: the fix of "_Zb" in camel case is "zb".
+	var violations []Violation
+	delta := 0
+	add := func(start, end int, rule, message, fix string) {
+		delta += len(fix) - (end - start)
+
+		// Adjacent letters of the wrong case and adjacent invalid
+		// characters are reported as a single violation.
+		if n := len(violations); n > 0 {
+			last := &violations[n-1]
+			merge := rule == "invalid-character" ||
+				strings.HasPrefix(rule, "uppercase-in-") ||
+				strings.HasPrefix(rule, "lowercase-in-")
+			if merge && last.Rule == rule && last.End == start {
+				last.End, last.Fix = end, last.Fix+fix
+				return
+			}
+		}
+
+		violations = append(violations,
+			Violation{start, end, rule, message, fix})
+	}
+
+	wordBegins := true
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		end := i + size
+
+		switch {
+		case v.delimiter != 0 && r == v.delimiter:
+			// The whole run of delimiters is checked at once.
+			for end < len(s) && rune(s[end]) == v.delimiter {
+				end++
+			}
+
+			switch {
+			case i+delta == 0 && v.noLeading:
+				add(i, end, "leading-separator",
+					"value starts with a delimiter", "")
+			case end == len(s) && v.strict:
+				add(i, end, "trailing-separator",
+					"value ends with a delimiter", "")
+			case end-i > 1 && v.strict:
+				add(i, end, "consecutive-separators",
+					"consecutive delimiters", string(v.delimiter))
+			}
+
+			wordBegins = true
+			i = end
+			continue
+		case v.joiner != 0 && r == v.joiner:
+			// Words of a segment are joined like kebab-case, so the joiner
+			// can't start or end a segment and can't be repeated. A value
+			// of a single segment uses the delimiter instead.
+			for end < len(s) && rune(s[end]) == v.joiner {
+				end++
+			}
+
+			next, _ := utf8.DecodeRuneInString(s[end:])
+			switch {
+			case i == 0:
+				add(i, end, "leading-separator",
+					"value starts with a delimiter", "")
+			case end == len(s):
+				add(i, end, "trailing-separator",
+					"value ends with a delimiter", "")
+			case !strings.ContainsRune(s, v.delimiter):
+				add(i, end, "invalid-separator",
+					fmt.Sprintf("delimiter %q instead of %q", r, v.delimiter),
+					string(v.delimiter))
+			case wordBegins || next == v.delimiter:
+				add(i, end, "consecutive-separators",
+					"consecutive delimiters", "")
+			case end-i > 1:
+				add(i, end, "consecutive-separators",
+					"consecutive delimiters", string(v.joiner))
+			}
+
+			i = end
+			continue
+		case !isASCIIAlnum(r) && v.delimiter == 0 && isSeparatorRune(r):
+			// A delimiter inside a united style is replaced with
+			// the capital letter of the next word.
+			fix := ""
+			if n, nsize := utf8.DecodeRuneInString(s[end:]); i+delta > 0 &&
+				unicode.IsLower(n) {
+				fix, end = string(unicode.ToUpper(n)), end+nsize
+			}
+
+			add(i, end, "invalid-separator",
+				fmt.Sprintf("delimiter %q in %s case", r, v.name), fix)
+			wordBegins = i+delta == 0
+			i = end
+			continue
+		case !isASCIIAlnum(r) && isSeparatorRune(r):
+			add(i, end, "invalid-separator",
+				fmt.Sprintf("delimiter %q instead of %q", r, v.delimiter),
+				string(v.delimiter))
+			wordBegins = true
+			i = end
+			continue
+		case !isASCIIAlnum(r):
+			add(i, end, "invalid-character",
+				fmt.Sprintf("character %q isn't allowed", r), "")
+			i = end
+			continue
+		}
+
+		lower, upper := unicode.IsLower(r), unicode.IsUpper(r)
+		prev, _ := utf8.DecodeLastRuneInString(s[:i])
+		first := i+delta == 0
+		switch {
+		case first && unicode.IsDigit(r) &&
+			(v.start == lowerStart || v.start == upperStart):
+			add(i, end, "leading-digit", "value starts with a digit", "")
+		case first && v.start == lowerStart && upper:
+			add(i, end, "uppercase-start",
+				"value starts with a capital letter",
+				string(unicode.ToLower(r)))
+		case first && v.start == upperStart && lower:
+			add(i, end, "lowercase-start",
+				"value starts with a lowercase letter",
+				string(unicode.ToUpper(r)))
+		case wordBegins && v.start == titledStarts && lower:
+			add(i, end, "lowercase-word-start",
+				"word starts with a lowercase letter",
+				string(unicode.ToUpper(r)))
+		case !wordBegins && v.start == titledStarts && upper &&
+			unicode.IsLower(prev):
+			// A capital letter after a lowercase one starts a new word.
+			add(i, end, "uppercase-in-"+v.name,
+				"capital letter inside a word",
+				string(v.delimiter)+string(r))
+		case v.letters == lowerLetters && upper:
+			add(i, end, "uppercase-in-"+v.name,
+				fmt.Sprintf("capital letter in %s case", v.name),
+				string(unicode.ToLower(r)))
+		case v.letters == upperLetters && lower:
+			add(i, end, "lowercase-in-"+v.name,
+				fmt.Sprintf("lowercase letter in %s case", v.name),
+				string(unicode.ToUpper(r)))
+		}
+
+		wordBegins = false
+		i = end
+	}
+
+	return violations
+}
+
+// Validate explains why the string isn't written in the style.
+//
+// It returns the list of violations of the rules of the style, ordered
+// by their offsets. Each violation contains the byte offsets of the part
+// of the string that breaks a rule, the rule ID, a message and a suggested
+// replacement of that part. The list is empty if and only if the string is
+// written in the style, i.e. the corresponding StrIs* function returns true.
+//
+// The fixes are computed against the already corrected part of the string,
+// so replacing every violation with its fix, starting from the end, gives
+// a string written in the style. If the list ends with the invalid-format
+// violation that covers the whole string, only its fix should be applied.
+//
+// The rule IDs are:
+//
+//   - empty: the string is empty;
+//   - invalid-character: a character that isn't allowed in the style;
+//   - invalid-separator: a delimiter that isn't used by the style;
+//   - leading-separator, trailing-separator, consecutive-separators:
+//     misplaced delimiters of the style;
+//   - leading-digit: the value starts with a digit (camelCase, PascalCase);
+//   - uppercase-start, lowercase-start: the wrong case of the first letter
+//     (camelCase, PascalCase);
+//   - lowercase-word-start: a word starts with a lowercase letter
+//     (Train-Case, Backslash\Case);
+//   - uppercase-in-snake, uppercase-in-kebab, uppercase-in-dot,
+//     uppercase-in-path, lowercase-in-screaming-snake: the wrong case
+//     of a letter;
+//   - uppercase-in-train, uppercase-in-backslash: a capital letter inside
+//     a word, the fix inserts the delimiter before it;
+//   - invalid-format: the string doesn't match the style for any other
+//     reason or the fixes of the other violations don't make it valid
+//     together; the fix is the whole string converted to the style;
+//   - unknown-style: the style isn't registered.
+//
+// Styles added by the Register function are checked with their Is method
+// only, so they report the invalid-format violation.
+//
+// Example usage:
+//
+//	violations := scs.Validate("user__Name", scs.Snake)
+//	// [{6 7 uppercase-in-snake capital letter in snake case n}]
+//
+//	violations = scs.Validate("1stPlace", scs.Camel)
+//	// [{0 1 leading-digit value starts with a digit }]
+func Validate(s string, style CaseStyle) []Violation {
+	return defaultConverter.Validate(s, style)
+}
+
+// Validate explains why the string isn't written in the style. The fix
+// of the invalid-format violation is the string converted with the rules
+// of the converter. See the Validate function for details.
+func (c *Converter) Validate(s string, style CaseStyle) []Violation {
+	e, ok := lookup(style)
+	if !ok {
+		return []Violation{{0, len(s), "unknown-style",
+			"case style isn't registered", s}}
+	}
+
+	if e.style.Is(s) {
+		return nil
+	}
+
+	if s == "" {
+		return []Violation{{0, 0, "empty", "value is empty", ""}}
+	}
+
+	var violations []Violation
+	if v, ok := validations[style]; ok {
+		violations = v.check(s)
+	}
+
+	// The fixes of the rules don't cover every quirk of the styles,
+	// so the whole value is suggested if they don't make it valid.
+	if len(violations) == 0 || !e.style.Is(applyFixes(s, violations)) {
+		violations = append(violations, Violation{0, len(s), "invalid-format",
+			fmt.Sprintf("value isn't %s style", e.name),
+			c.strTo(s, e)})
+	}
+
+	return violations
+}
+
+// The applyFixes returns the string with the fixes of the violations
+// applied. The violations must be ordered by offsets and not overlap.
+func applyFixes(s string, violations []Violation) string {
+	var builder strings.Builder
+	pos := 0
+	for _, v := range violations {
+		builder.WriteString(s[pos:v.Offset])
+		builder.WriteString(v.Fix)
+		pos = v.End
+	}
+
+	builder.WriteString(s[pos:])
+	return builder.String()
+}
@@ -0,0 +1,199 @@
+package scs
+
+import "testing"
+
+// TestValidate tests Validate function.
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		value  string
+		style  CaseStyle
+		result []Violation
+	}{
+		{"user_name", Snake, nil},
+		{"user__Name", Snake, []Violation{
+			{6, 7, "uppercase-in-snake", "", "n"},
+		}},
+		{"UserName", Snake, []Violation{
+			{0, 1, "uppercase-in-snake", "", "u"},
+			{4, 5, "uppercase-in-snake", "", "n"},
+		}},
+		{"user-name", Snake, []Violation{
+			{4, 5, "invalid-separator", "", "_"},
+		}},
+		{"user_name", ScreamingSnake, []Violation{
+			{0, 4, "lowercase-in-screaming-snake", "", "USER"},
+			{5, 9, "lowercase-in-screaming-snake", "", "NAME"},
+		}},
+		{"-user-name", Kebab, []Violation{
+			{0, 1, "leading-separator", "", ""},
+		}},
+		{"user-", Kebab, []Violation{
+			{0, 5, "invalid-format", "", "user"},
+		}},
+		{"1stPlace", Camel, []Violation{
+			{0, 1, "leading-digit", "", ""},
+		}},
+		{"UserName", Camel, []Violation{
+			{0, 1, "uppercase-start", "", "u"},
+		}},
+		{"_Zb", Camel, []Violation{
+			{0, 1, "invalid-separator", "", ""},
+			{1, 2, "uppercase-start", "", "z"},
+		}},
+		{"_b.", Snake, []Violation{
+			{2, 3, "invalid-separator", "", "_"},
+			{0, 3, "invalid-format", "", "b"},
+		}},
+		{"user_name", Camel, []Violation{
+			{4, 6, "invalid-separator", "", "N"},
+		}},
+		{"userName", Pascal, []Violation{
+			{0, 1, "lowercase-start", "", "U"},
+		}},
+		{"user-name", Train, []Violation{
+			{0, 1, "lowercase-word-start", "", "U"},
+			{5, 6, "lowercase-word-start", "", "N"},
+		}},
+		{"User--Name-", Train, []Violation{
+			{4, 6, "consecutive-separators", "", "-"},
+			{10, 11, "trailing-separator", "", ""},
+		}},
+		{"user..name", Dot, []Violation{
+			{4, 6, "consecutive-separators", "", "."},
+		}},
+		{"/user/name", Path, []Violation{
+			{0, 1, "leading-separator", "", ""},
+		}},
+		{"user/Name", Path, []Violation{
+			{5, 6, "uppercase-in-path", "", "n"},
+		}},
+		{"user/-profile--image-", Path, []Violation{
+			{5, 6, "consecutive-separators", "", ""},
+			{13, 15, "consecutive-separators", "", "-"},
+			{20, 21, "trailing-separator", "", ""},
+		}},
+		{"profile-image", Path, []Violation{
+			{7, 8, "invalid-separator", "", "/"},
+		}},
+		{"UserName-Id", Train, []Violation{
+			{4, 5, "uppercase-in-train", "", "-N"},
+		}},
+		{`App\HttpClient`, Backslash, []Violation{
+			{8, 9, "uppercase-in-backslash", "", `\C`},
+		}},
+		{`User\name`, Backslash, []Violation{
+			{5, 6, "lowercase-word-start", "", "N"},
+		}},
+		{"usér_name", Snake, []Violation{
+			{2, 4, "invalid-character", "", ""},
+		}},
+		{"", Camel, []Violation{
+			{0, 0, "empty", "", ""},
+		}},
+		{"user", 0, []Violation{
+			{0, 4, "unknown-style", "", "user"},
+		}},
+		{"user_name", adaCase, []Violation{
+			{0, 9, "invalid-format", "", "User_Name"},
+		}},
+	}
+
+	for i, test := range tests {
+		r := Validate(test.value, test.style)
+		if len(r) != len(test.result) {
+			t.Errorf("test for %d is failed, expected %v but %v",
+				i, test.result, r)
+			continue
+		}
+
+		for j, v := range r {
+			// Messages are not compared.
+			v.Message = ""
+			if v != test.result[j] {
+				t.Errorf("test for %d is failed, expected %v but %v",
+					i, test.result[j], v)
+			}
+		}
+	}
+}
+
+// TestValidateIs tests that Validate function finds no violations
+// only for the values in the style.
+func TestValidateIs(t *testing.T) {
+	values := []string{
+		"", "hello", "HELLO", "helloWorld", "HelloWorld", "hello_world",
+		"_hello_world_", "hello__world", "a_", "HELLO_WORLD", "hello-world",
+		"hello--world", "a-", "-a", "Hello-World", "Hello--World",
+		"hello.world", "hello..world", "hello/world", `Hello\World`,
+		"hello/big-world", "hello/-world", "User-IDs", "HTTP-Server",
+		"HTTPServer-X", `App\HttpClient`, "hello-/world", "-hello/world",
+		"1hello", "1Hello", "hello world", "Hello World", "héllo",
+	}
+
+	for _, e := range entries() {
+		for _, value := range values {
+			valid := len(Validate(value, e.flag)) == 0
+			if valid != e.style.Is(value) {
+				t.Errorf("test for %s in %s is failed, expected %t but %t",
+					value, e.name, e.style.Is(value), valid)
+			}
+		}
+	}
+}
+
+// TestValidateFixes tests that applying the fixes of the violations
+// gives a string in the style.
+func TestValidateFixes(t *testing.T) {
+	values := []string{
+		"_b.", "_Zb", "_hello", "user__Name", "1stPlace", "hello world",
+		"Hello World", "HELLO_WORLD", "hello-World", "helloWorld",
+		"hello_World.json", "-hello/World", `App\httpClient`, "a_",
+		"-a", "hello--world", "x-Y_z", "HTTPServer-X", "hello/-world",
+	}
+
+	for _, e := range entries() {
+		for _, value := range values {
+			violations := Validate(value, e.flag)
+			if len(violations) == 0 {
+				continue
+			}
+
+			fixed := ""
+			if last := violations[len(violations)-1]; last.Rule ==
+				"invalid-format" && last.Offset == 0 && last.End == len(value) {
+				fixed = last.Fix
+			} else {
+				fixed = applyFixes(value, violations)
+			}
+
+			if !e.style.Is(fixed) {
+				t.Errorf("test for %s in %s is failed, expected valid fix "+
+					"but %s", value, e.name, fixed)
+			}
+		}
+	}
+}
+
+// TestConverterValidate tests that the Validate method of the converter
+// suggests the value converted with the rules of the converter.
+func TestConverterValidate(t *testing.T) {
+	c, _ := NewConverter(WithAcronymPolicy(TitleOnly))
+	tests := []struct {
+		value     string
+		style     CaseStyle
+		converter *Converter
+		result    string
+	}{
+		{"HTTPServer", Train, defaultConverter, "HTTP-Server"},
+		{"HTTPServer", Train, c, "Http-Server"},
+		{"HTTP_", Backslash, c, `Http`},
+	}
+
+	for i, test := range tests {
+		r := test.converter.Validate(test.value, test.style)
+		if fix := r[len(r)-1].Fix; fix != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, fix)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package scs
+
+import "strings"
+
+// The indexWords returns the index of the first occurrence of the sequence
+// of words in the words starting from the position, or -1 if there is
+// no such sequence. Words are compared case-insensitively.
+func indexWords(words, seq []string, from int) int {
+	if len(seq) == 0 {
+		return -1
+	}
+
+	for i := from; i+len(seq) <= len(words); i++ {
+		if hasWordsAt(words, seq, i) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// The hasWordsAt returns true if the sequence of words is found
+// at the position of the words.
+func hasWordsAt(words, seq []string, at int) bool {
+	if len(seq) == 0 || at < 0 || at+len(seq) > len(words) {
+		return false
+	}
+
+	for j, word := range seq {
+		if !strings.EqualFold(words[at+j], word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// The slice returns a new name with the words from i to j.
+// The indexes are clamped to the bounds of the name.
+func (n Name) slice(i, j int) Name {
+	i = clamp(i, 0, len(n.words))
+	j = clamp(j, i, len(n.words))
+
+	return Name{
+		words:    append([]string(nil), n.words[i:j]...),
+		acronyms: append([]bool(nil), n.acronyms[i:j]...),
+	}
+}
+
+// The clamp returns the value limited by the bounds.
+func clamp(v, low, high int) int {
+	switch {
+	case v < low:
+		return low
+	case v > high:
+		return high
+	}
+
+	return v
+}
+
+// The rewrite changes the words of the value of the object and re-renders
+// them in the style of the object. The object remains unchanged if its
+// style isn't registered.
+func (o *StringCaseStyle) rewrite(
+	fn func(c *Converter, n Name) Name,
+) *StringCaseStyle {
+	c := o.rules()
+	if value, err := fn(c, c.ParseName(o.value)).Format(o.style); err == nil {
+		o.value = value
+	}
+
+	return o
+}
+
+// The parseWords parses the strings into a single name.
+func (c *Converter) parseWords(words []string) Name {
+	return c.ParseName(strings.Join(words, " "))
+}
+
+// Words returns the words of the value of the object in natural spelling:
+// abbreviations are written according to the rules of the object and all
+// other words are written in lower case.
+//
+// Example usage:
+//
+//	style, _ := New(Pascal, "GetUserID")
+//	style.Words() // [get user ID]
+func (o *StringCaseStyle) Words() []string {
+	return o.Name().Words()
+}
+
+// Prepend adds the words before the value of the object and re-renders
+// the value in the style of the object. Each argument can contain several
+// words in any style. The updated object is returned for method chaining.
+//
+// Example usage:
+//
+//	style, _ := New(Pascal, "UserID")
+//	style.Prepend("get").Value() // GetUserID
+func (o *StringCaseStyle) Prepend(words ...string) *StringCaseStyle {
+	return o.rewrite(func(c *Converter, n Name) Name {
+		return c.parseWords(words).concat(n)
+	})
+}
+
+// Append adds the words after the value of the object and re-renders
+// the value in the style of the object. Each argument can contain several
+// words in any style. The updated object is returned for method chaining.
+//
+// Example usage:
+//
+//	style, _ := New(Pascal, "User")
+//	style.Append("id").Value() // UserID
+//
+//	style.Append("service").Value() // UserIDService
+func (o *StringCaseStyle) Append(words ...string) *StringCaseStyle {
+	return o.rewrite(func(c *Converter, n Name) Name {
+		return n.concat(c.parseWords(words))
+	})
+}
+
+// TrimPrefixWord removes the first word of the value of the object if it
+// is one of the given words, and re-renders the value in the style of the
+// object. Words are compared case-insensitively, an argument of several
+// words removes all of them. Only one prefix is removed. The updated object
+// is returned for method chaining.
+//
+// Example usage:
+//
+//	style, _ := New(Snake, "GetUserID")
+//	style.TrimPrefixWord("get", "set", "is").Value() // user_id
+func (o *StringCaseStyle) TrimPrefixWord(words ...string) *StringCaseStyle {
+	return o.rewrite(func(c *Converter, n Name) Name {
+		for _, word := range words {
+			prefix := c.ParseName(word).words
+			if hasWordsAt(n.words, prefix, 0) {
+				return n.slice(len(prefix), n.Len())
+			}
+		}
+
+		return n
+	})
+}
+
+// TrimSuffixWord removes the last word of the value of the object if it
+// is one of the given words, and re-renders the value in the style of the
+// object. Words are compared case-insensitively, an argument of several
+// words removes all of them. Only one suffix is removed. The updated object
+// is returned for method chaining.
+//
+// Example usage:
+//
+//	style, _ := New(Pascal, "UserService")
+//	style.TrimSuffixWord("service").Value() // User
+func (o *StringCaseStyle) TrimSuffixWord(words ...string) *StringCaseStyle {
+	return o.rewrite(func(c *Converter, n Name) Name {
+		for _, word := range words {
+			suffix := c.ParseName(word).words
+			if hasWordsAt(n.words, suffix, n.Len()-len(suffix)) {
+				return n.slice(0, n.Len()-len(suffix))
+			}
+		}
+
+		return n
+	})
+}
+
+// ReplaceWord replaces all occurrences of the old words in the value
+// of the object with the new words, and re-renders the value in the style
+// of the object. Words are compared case-insensitively, both arguments can
+// contain several words in any style. The updated object is returned for
+// method chaining.
+//
+// Example usage:
+//
+//	style, _ := New(Camel, "userID")
+//	style.ReplaceWord("user", "account").Value() // accountID
+//
+//	style.ReplaceWord("account id", "uuid").Value() // uuid
+func (o *StringCaseStyle) ReplaceWord(old, new string) *StringCaseStyle {
+	return o.rewrite(func(c *Converter, n Name) Name {
+		seq, replacement := c.ParseName(old).words, c.ParseName(new)
+
+		var result Name
+		i := 0
+		for {
+			j := indexWords(n.words, seq, i)
+			if j < 0 {
+				break
+			}
+
+			result = result.concat(n.slice(i, j)).concat(replacement)
+			i = j + len(seq)
+		}
+
+		return result.concat(n.slice(i, n.Len()))
+	})
+}
+
+// Slice keeps the words of the value of the object from i to j, and
+// re-renders the value in the style of the object. The indexes are
+// clamped to the number of words, so Slice(1, len) removes the first
+// word. The updated object is returned for method chaining.
+//
+// Example usage:
+//
+//	style, _ := New(Kebab, "get-user-id")
+//	style.Slice(1, 3).Value() // user-id
+func (o *StringCaseStyle) Slice(i, j int) *StringCaseStyle {
+	return o.rewrite(func(c *Converter, n Name) Name {
+		return n.slice(i, j)
+	})
+}
@@ -0,0 +1,122 @@
+package scs
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestObjWords tests Words method of the object.
+func TestObjWords(t *testing.T) {
+	tests := []struct {
+		style  CaseStyle
+		value  string
+		result []string
+	}{
+		{Pascal, "GetUserID", []string{"get", "user", "ID"}},
+		{Snake, "is_active", []string{"is", "active"}},
+		{Kebab, "", nil},
+	}
+
+	for i, test := range tests {
+		obj, _ := New(test.style, test.value)
+		if r := obj.Words(); !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test for %d is failed, expected %v but %v",
+				i, test.result, r)
+		}
+	}
+}
+
+// TestObjWordOperations tests word operations of the object.
+func TestObjWordOperations(t *testing.T) {
+	tests := []struct {
+		style  CaseStyle
+		value  string
+		do     func(o *StringCaseStyle) *StringCaseStyle
+		result string
+	}{
+		{Pascal, "User", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.Append("service")
+		}, "UserService"},
+		{Pascal, "User", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.Append("id")
+		}, "UserID"},
+		{Snake, "user", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.Append("id", "LIST")
+		}, "user_id_list"},
+		{Camel, "userID", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.Prepend("get")
+		}, "getUserID"},
+		{Kebab, "user", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.Prepend("HTTPClient")
+		}, "http-client-user"},
+		{Snake, "GetUserID", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.TrimPrefixWord("get", "set", "is")
+		}, "user_id"},
+		{Pascal, "is_active", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.TrimPrefixWord("get", "set", "is")
+		}, "Active"},
+		{Pascal, "UserName", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.TrimPrefixWord("get")
+		}, "UserName"},
+		{Pascal, "SetUserName", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.TrimPrefixWord("set user")
+		}, "Name"},
+		{Pascal, "UserService", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.TrimSuffixWord("handler", "service")
+		}, "User"},
+		{Snake, "user_id", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.TrimSuffixWord("ID")
+		}, "user"},
+		{Snake, "id", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.TrimSuffixWord("user id")
+		}, "id"},
+		{Camel, "userID", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.ReplaceWord("user", "account")
+		}, "accountID"},
+		{Snake, "user_id_by_user", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.ReplaceWord("USER", "group")
+		}, "group_id_by_group"},
+		{Pascal, "GetUserID", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.ReplaceWord("user_id", "url")
+		}, "GetURL"},
+		{Pascal, "GetUserID", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.ReplaceWord("name", "url")
+		}, "GetUserID"},
+		{Kebab, "get-user-id", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.Slice(1, 3)
+		}, "user-id"},
+		{Kebab, "get-user-id", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.Slice(-1, 100)
+		}, "get-user-id"},
+		{Kebab, "get-user-id", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.Slice(2, 1)
+		}, ""},
+		{Pascal, "GetUserID", func(o *StringCaseStyle) *StringCaseStyle {
+			return o.TrimPrefixWord("get").Append("list").Slice(1, 3)
+		}, "IDList"},
+	}
+
+	for i, test := range tests {
+		obj, _ := New(test.style, test.value)
+		if r := test.do(obj).Value(); r != test.result {
+			t.Errorf("test for %d is failed, expected %s but %s",
+				i, test.result, r)
+		}
+	}
+}
+
+// TestObjWordOperationsRules tests that word operations respect
+// the rules of the object.
+func TestObjWordOperationsRules(t *testing.T) {
+	obj, _ := New(Pascal, "user")
+	obj.SetAcronymPolicy(TitleOnly)
+	if r := obj.Append("id").Value(); r != "UserId" {
+		t.Errorf("expected UserId but %s", r)
+	}
+
+	// The object with an unknown style remains unchanged.
+	invalid, _ := New(0, "user")
+	if r := invalid.Append("id").Value(); r != "" {
+		t.Errorf("expected empty value but %s", r)
+	}
+}
@@ -3,78 +3,117 @@ package scs
 import (
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
-// The getChunks clears the string of special characters and splits the
-// string by whitespace and returns a list of words ignoring empty elements.
-func getChunks(s string) []string {
-	chunks := make([]string, 0, strings.Count(s, " ")+1)
-	var builder strings.Builder
-	builder.Grow(len(s))
-
-	for _, r := range strings.ToLower(s) {
-		if unicode.IsLetter(r) || unicode.IsNumber(r) {
-			builder.WriteRune(r)
-		} else if builder.Len() > 0 {
-			chunks = append(chunks, builder.String())
-			builder.Reset()
+// The getChunks returns the list of words of the string of any format
+// in lower case, ignoring separators.
+func (c *Converter) getChunks(s string) []string {
+	return lowerAll(c.Words(s))
+}
+
+// The lowerAll converts all words to lower case.
+func lowerAll(words []string) []string {
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+
+	return words
+}
+
+// The spell returns words in their natural spelling: abbreviations are
+// written according to the acronym policy of the converter, any other
+// word is written in lower case.
+func (c *Converter) spell(chunks []string) []string {
+	c.abbreviations.RLock()
+	defer c.abbreviations.RUnlock()
+
+	words := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		v, ok := c.abbreviations.find(chunk)
+		switch {
+		case !ok:
+			words[i] = strings.ToLower(chunk)
+		case c.acronyms == TitleOnly:
+			words[i] = toTitle(strings.ToLower(v))
+		case c.acronyms == Preserve &&
+			strings.IndexFunc(chunk, unicode.IsUpper) >= 0:
+			words[i] = chunk
+		default:
+			words[i] = v
 		}
 	}
 
-	if builder.Len() > 0 {
-		chunks = append(chunks, builder.String())
+	return words
+}
+
+// The toTitle returns the word with the first letter in upper case.
+// Words that already contain capital letters, such as abbreviations,
+// remain unchanged.
+func toTitle(word string) string {
+	if word == "" || strings.ToLower(word) != word {
+		return word
 	}
 
-	return chunks
+	r, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToUpper(r)) + word[size:]
 }
 
-// The toUnited converts a string to a format similar to camel or PascalCase.
-func toUnited(s string, firstWordIsLower bool) string {
-	chunks := getChunks(s)
-	if len(chunks) == 0 {
+// The joinUnited joins words into a format similar to camel or PascalCase.
+func joinUnited(words []string, firstWordIsLower bool) string {
+	if len(words) == 0 {
 		return ""
 	}
 
 	var builder strings.Builder
-	builder.Grow(len(s))
 
 	// Перше слово
 	if firstWordIsLower {
-		builder.WriteString(chunks[0])
-	} else if v, ok := abbreviations[chunks[0]]; ok {
-		builder.WriteString(v)
+		builder.WriteString(strings.ToLower(words[0]))
 	} else {
-		builder.WriteString(strings.Title(chunks[0]))
+		builder.WriteString(toTitle(words[0]))
 	}
 
 	// Решта слів
-	for _, chunk := range chunks[1:] {
-		if v, ok := abbreviations[chunk]; ok {
-			builder.WriteString(v)
-		} else {
-			builder.WriteString(strings.Title(chunk))
-		}
+	for _, word := range words[1:] {
+		builder.WriteString(toTitle(word))
 	}
 
 	return builder.String()
 }
 
-// The toSeparate converts a string to a format similar to snake or kebab-case.
-func toSeparate(s, delimiter string) string {
-	chunks := getChunks(s)
-	if len(chunks) == 0 {
-		return ""
+// The joinSeparate joins words into a format similar to snake or kebab-case.
+func joinSeparate(words []string, delimiter string) string {
+	return strings.Join(words, delimiter)
+}
+
+// The joinTitled joins words into a format similar to Train-Case,
+// where every word is capitalized and separated by a delimiter.
+func joinTitled(words []string, delimiter string) string {
+	titled := make([]string, len(words))
+	for i, word := range words {
+		// Abbreviations of mixed case, such as "WiFi", are capitalized
+		// as regular words, so the result is a valid titled word.
+		if titled[i] = toTitle(word); !isTitledWord.MatchString(titled[i]) {
+			titled[i] = toTitle(strings.ToLower(word))
+		}
 	}
 
-	var builder strings.Builder
-	totalLen := len(s) + len(chunks) - 1
-	builder.Grow(totalLen)
+	return strings.Join(titled, delimiter)
+}
 
-	builder.WriteString(chunks[0])
-	for _, chunk := range chunks[1:] {
-		builder.WriteString(delimiter)
-		builder.WriteString(chunk)
-	}
+// The toUnited converts a string to a format similar to camel or PascalCase.
+func (c *Converter) toUnited(s string, firstWordIsLower bool) string {
+	return joinUnited(c.spell(c.Words(s)), firstWordIsLower)
+}
 
-	return builder.String()
+// The toSeparate converts a string to a format similar to snake or kebab-case.
+func (c *Converter) toSeparate(s, delimiter string) string {
+	return joinSeparate(c.getChunks(s), delimiter)
+}
+
+// The toTitled converts a string to a format similar to Train-Case,
+// where every word is capitalized and separated by a delimiter.
+func (c *Converter) toTitled(s, delimiter string) string {
+	return joinTitled(c.spell(c.Words(s)), delimiter)
 }
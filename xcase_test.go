@@ -9,7 +9,7 @@ import (
 func TestGetChunks(t *testing.T) {
 	var expected = []string{"russian", "warship", "go", "fuck", "yourself"}
 
-	chunks := getChunks("Russian warship, go fuck yourself!")
+	chunks := defaultConverter.getChunks("Russian warship, go fuck yourself!")
 	for i, r := range chunks {
 		if e := expected[i]; e != r {
 			t.Errorf("expected %s but %s", e, r)
@@ -26,13 +26,13 @@ func TestToUnited(t *testing.T) {
 	var expected = "russianWarshipGoFuckYourself"
 
 	// The camelCase
-	result := toUnited("Russian warship, go fuck yourself!", true)
+	result := defaultConverter.toUnited("Russian warship, go fuck yourself!", true)
 	if result != expected {
 		t.Errorf("expected %s but %s", expected, result)
 	}
 
 	// The PascalCase
-	result = toUnited("Russian warship, go fuck yourself!", false)
+	result = defaultConverter.toUnited("Russian warship, go fuck yourself!", false)
 	if result != strings.Title(expected) {
 		t.Errorf("expected %s but %s", strings.Title(expected), result)
 	}
@@ -42,7 +42,7 @@ func TestToUnited(t *testing.T) {
 func TestToSeparate(t *testing.T) {
 	var expected = "russian:warship:go:fuck:yourself"
 
-	result := toSeparate("Russian warship, go fuck yourself!", ":")
+	result := defaultConverter.toSeparate("Russian warship, go fuck yourself!", ":")
 	if result != expected {
 		t.Errorf("expected %s but %s", expected, result)
 	}